@@ -68,6 +68,14 @@ type AlluxioCompTemplateSpec struct {
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// CPUPolicy asks for guaranteed QoS with CPU manager pinning when set to `pinned`:
+	// CPU and memory requests are raised to their limits so that kubelet's static CPU
+	// manager and NUMA-aware memory manager align the component to exclusive cores.
+	// Requires whole CPUs in the resource limits.
+	// +kubebuilder:validation:Enum=none;pinned;""
+	// +optional
+	CPUPolicy CPUPolicy `json:"cpuPolicy,omitempty"`
+
 	// Environment variables that will be used by Alluxio component. <br>
 	Env map[string]string `json:"env,omitempty"`
 
@@ -90,6 +98,11 @@ type AlluxioCompTemplateSpec struct {
 	// PodMetadata defines labels and annotations that will be propagated to Alluxio's pods
 	// +optional
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
+
+	// UpdateStrategy overrides the update strategy of the workload generated for the
+	// component, e.g. to roll masters one ordinal at a time or to pin workers to OnDelete
+	// +optional
+	UpdateStrategy *ComponentUpdateStrategy `json:"updateStrategy,omitempty"`
 }
 
 // AlluxioFuseSpec is a description of the Alluxio Fuse
@@ -123,11 +136,21 @@ type AlluxioFuseSpec struct {
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// CPUPolicy asks for guaranteed QoS with CPU manager pinning when set to `pinned`:
+	// CPU and memory requests are raised to their limits so that kubelet's static CPU
+	// manager and NUMA-aware memory manager align the fuse client to exclusive cores.
+	// Requires whole CPUs in the resource limits.
+	// +kubebuilder:validation:Enum=none;pinned;""
+	// +optional
+	CPUPolicy CPUPolicy `json:"cpuPolicy,omitempty"`
+
 	// Arguments that will be passed to Alluxio Fuse
 	Args []string `json:"args,omitempty"`
 
 	// If the fuse client should be deployed in global mode,
 	// otherwise the affinity should be considered
+	// Deprecated: fuse pods follow the application pods mounting the dataset, this
+	// field will be removed in a future release
 	// +optional
 	Global bool `json:"global,omitempty"`
 
@@ -155,6 +178,11 @@ type AlluxioFuseSpec struct {
 	// PodMetadata defines labels and annotations that will be propagated to Alluxio's fuse pods
 	// +optional
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
+
+	// UpdateStrategy overrides the update strategy of the fuse DaemonSet, e.g. to roll
+	// fuse pods with a bounded unavailability instead of the OnDelete default
+	// +optional
+	UpdateStrategy *ComponentUpdateStrategy `json:"updateStrategy,omitempty"`
 }
 
 // Level describes configurations a tier needs. <br>
@@ -258,6 +286,11 @@ type AlluxioRuntimeSpec struct {
 	// The component spec of Alluxio API Gateway
 	APIGateway AlluxioCompTemplateSpec `json:"apiGateway,omitempty"`
 
+	// The component spec of the optional NFS gateway, which exports the cached dataset
+	// read-only over NFSv4 so that clients outside Kubernetes can mount warm data
+	// +optional
+	NFSGateway AlluxioCompTemplateSpec `json:"nfsGateway,omitempty"`
+
 	// The spec of init users
 	InitUsers InitUsersSpec `json:"initUsers,omitempty"`
 
@@ -303,6 +336,11 @@ type AlluxioRuntimeSpec struct {
 	// +optional
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
 
+	// SecondaryNetwork attaches master, worker and fuse pods to a secondary high-bandwidth
+	// network for data traffic, keeping kube traffic on the primary network.
+	// +optional
+	SecondaryNetwork *SecondaryNetwork `json:"secondaryNetwork,omitempty"`
+
 	// RuntimeManagement defines policies when managing the runtime
 	// +optional
 	RuntimeManagement RuntimeManagement `json:"management,omitempty"`
@@ -19,6 +19,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // InitUsersSpec is a description of the initialize the users for runtime
@@ -71,6 +72,30 @@ type VersionSpec struct {
 	ImagePullPolicy string `json:"imagePullPolicy,omitempty"`
 }
 
+// ComponentUpdateStrategy overrides the update strategy of the workload generated for a
+// runtime component, because the chart defaults are not appropriate for all fleets.
+type ComponentUpdateStrategy struct {
+	// Type of the update strategy, either `RollingUpdate` or `OnDelete`. When unset it
+	// is inferred: `RollingUpdate` if any rolling update parameter is set, otherwise the
+	// workload's default strategy is kept.
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete;""
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// MaxUnavailable bounds how many pods of the component may be unavailable during a
+	// rolling update, an absolute number or a percentage. Only DaemonSet components
+	// (the fuse) honor it.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Partition keeps pods with an ordinal greater than or equal to it on the old
+	// revision during a rolling update. Only StatefulSet components (master and worker)
+	// honor it.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Partition *int32 `json:"partition,omitempty"`
+}
+
 // Condition explains the transitions on phase
 type Condition struct {
 	// Type of condition, either `Complete` or `Failed`
@@ -141,14 +166,77 @@ type CleanCachePolicy struct {
 	MaxRetryAttempts *int32 `json:"maxRetryAttempts,omitempty"`
 }
 
+// CPUPolicy decides how kubelet's CPU manager allocates CPUs to a runtime component
+type CPUPolicy string
+
+const (
+	// CPUPolicyNone leaves CPU allocation to the default shared pool
+	CPUPolicyNone CPUPolicy = "none"
+
+	// CPUPolicyPinned asks for guaranteed QoS with whole CPUs so that kubelet's static
+	// CPU manager pins the component to exclusive cores with NUMA-aligned memory
+	CPUPolicyPinned CPUPolicy = "pinned"
+)
+
+// SecondaryNetwork attaches runtime pods to an additional network managed by Multus
+// through NetworkAttachmentDefinition references
+type SecondaryNetwork struct {
+	// NetworkAttachmentDefinitions references the attachments in '<name>' or
+	// '<namespace>/<name>' form, as accepted by the Multus networks annotation.
+	// +kubebuilder:validation:MinItems=1
+	NetworkAttachmentDefinitions []string `json:"networkAttachmentDefinitions"`
+
+	// DataInterface is the in-pod interface name of the secondary network (e.g. net1).
+	// When set, the engine binds its data traffic to this interface.
+	// +optional
+	DataInterface string `json:"dataInterface,omitempty"`
+}
+
 // MetadataSyncPolicy defines policies when syncing metadata
 type MetadataSyncPolicy struct {
 	// AutoSync enables automatic metadata sync when setting up a runtime. If not set, it defaults to true.
 	// +kubebuilder:default=true
 	// +optional
 	AutoSync *bool `json:"autoSync,omitempty"`
+
+	// Sharding splits a full metadata sync into per-prefix shards which are listed
+	// incrementally with bounded concurrency and checkpointed, so syncing huge under
+	// file systems doesn't rely on one single-threaded full listing. If not set,
+	// metadata is synced with one full listing.
+	// +optional
+	Sharding *MetadataSyncSharding `json:"sharding,omitempty"`
+}
+
+// MetadataSyncSharding tunes how a metadata sync lists the under file system in shards
+type MetadataSyncSharding struct {
+	// Concurrency is the maximum number of shards listed in parallel. If not set, it defaults to 4.
+	// +kubebuilder:default=4
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Concurrency *int32 `json:"concurrency,omitempty"`
+
+	// Checkpoint enables recording finished shards so that an interrupted sync resumes from
+	// where it stopped instead of restarting the full listing. If not set, it defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	Checkpoint *bool `json:"checkpoint,omitempty"`
 }
 
 func (msb *MetadataSyncPolicy) AutoSyncEnabled() bool {
 	return msb.AutoSync == nil || *msb.AutoSync
 }
+
+func (msb *MetadataSyncPolicy) ShardingEnabled() bool {
+	return msb.Sharding != nil
+}
+
+func (mss *MetadataSyncSharding) GetConcurrency() int32 {
+	if mss.Concurrency == nil {
+		return 4
+	}
+	return *mss.Concurrency
+}
+
+func (mss *MetadataSyncSharding) CheckpointEnabled() bool {
+	return mss.Checkpoint == nil || *mss.Checkpoint
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataArchiveSpec defines the desired state of DataArchive
+type DataArchiveSpec struct {
+	// Dataset defines the target dataset of the DataArchive
+	Dataset TargetDataset `json:"dataset,omitempty"`
+
+	// Paths defines the paths scanned for cold data, defaults to the dataset root
+	Paths []string `json:"paths,omitempty"`
+
+	// ColdAfterDays defines how many days a file must stay untouched before it counts
+	// as cold and gets evicted from the cache tiers
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	ColdAfterDays int32 `json:"coldAfterDays,omitempty"`
+
+	// TargetStorageClass optionally rewrites archived files to a cheaper storage class
+	// in the underlying storage (e.g. STANDARD_IA or GLACIER via S3 lifecycle tags),
+	// left empty the files are only tiered out of the cache
+	TargetStorageClass string `json:"targetStorageClass,omitempty"`
+
+	// DryRun only reports what would be archived without evicting or rewriting anything
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="WaitingFor",type="string",JSONPath=`.status.conditions[?(@.type=="Waiting")].reason`,priority=1
+// +kubebuilder:printcolumn:name="ArchivedBytes",type="string",JSONPath=`.status.infos.ArchivedBytes`
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=archive
+// +genclient
+
+// DataArchive is the Schema for the archive API. It moves cold cached data out of the
+// premium cache tiers and optionally rewrites it to a cheaper UFS storage class.
+type DataArchive struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataArchiveSpec `json:"spec,omitempty"`
+	Status OperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DataArchiveList contains a list of DataArchive
+type DataArchiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataArchive `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataArchive{}, &DataArchiveList{})
+}
@@ -34,6 +34,7 @@ type DataBackupSpec struct {
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset`
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="WaitingFor",type="string",JSONPath=`.status.conditions[?(@.type=="Waiting")].reason`,priority=1
 // +kubebuilder:printcolumn:name="Path",type="string",JSONPath=`.status.infos.BackupLocationPath`
 // +kubebuilder:printcolumn:name="NodeName",type="string",JSONPath=`.status.infos.BackupLocationNodeName`
 // +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
@@ -43,6 +43,24 @@ type TargetPath struct {
 	Replicas int32 `json:"replicas,omitempty"`
 }
 
+// ChecksumManifest describes where to find the checksum manifest accompanying the loaded
+// data and how to react on verification mismatches.
+type ChecksumManifest struct {
+	// Path of the manifest file within the dataset, e.g. "/checksums.sha256"
+	Path string `json:"path"`
+
+	// Algorithm of the checksums in the manifest, one of "sha256" and "md5"
+	// +kubebuilder:validation:Enum=sha256;md5
+	// +kubebuilder:default=sha256
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// FailOnMismatch fails the DataLoad when any loaded file doesn't match the manifest.
+	// When false, mismatches are only reported in the job's log.
+	// +optional
+	FailOnMismatch bool `json:"failOnMismatch,omitempty"`
+}
+
 // DataLoadSpec defines the desired state of DataLoad
 type DataLoadSpec struct {
 	// Dataset defines the target dataset of the DataLoad
@@ -54,6 +72,12 @@ type DataLoadSpec struct {
 	// Target defines target paths that needs to be loaded
 	Target []TargetPath `json:"target,omitempty"`
 
+	// VerificationManifest specifies a user-provided checksum manifest the loaded data is
+	// verified against after loading, for pipelines that need provable data integrity at
+	// ingest. Each manifest line holds "<checksum>  <path>" like the output of sha256sum.
+	// +optional
+	VerificationManifest *ChecksumManifest `json:"verificationManifest,omitempty"`
+
 	// Options specifies the extra dataload properties for runtime
 	Options map[string]string `json:"options,omitempty"`
 
@@ -73,10 +97,17 @@ type DataLoadSpec struct {
 
 	// +optional
 	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// RequireCostAck holds the DataLoad in Pending with the estimated egress cost
+	// surfaced in status, until the cost is acknowledged with the 'fluid.io/cost-ack: "true"'
+	// annotation on the DataLoad, preventing surprise cloud bills
+	// +optional
+	RequireCostAck bool `json:"requireCostAck,omitempty"`
 }
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="WaitingFor",type="string",JSONPath=`.status.conditions[?(@.type=="Waiting")].reason`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
 // +kubebuilder:object:root=true
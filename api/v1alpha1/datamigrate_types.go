@@ -57,6 +57,12 @@ type DataMigrateSpec struct {
 
 	// PodMetadata defines labels and annotations that will be propagated to DataLoad pods
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
+
+	// RequireCostAck holds the DataMigrate in Pending with the estimated egress cost
+	// surfaced in status, until the cost is acknowledged with the 'fluid.io/cost-ack: "true"'
+	// annotation on the DataMigrate, preventing surprise cloud bills
+	// +optional
+	RequireCostAck bool `json:"requireCostAck,omitempty"`
 }
 
 type Policy string
@@ -104,6 +110,7 @@ type ExternalStorage struct {
 }
 
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="WaitingFor",type="string",JSONPath=`.status.conditions[?(@.type=="Waiting")].reason`,priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
 // +kubebuilder:object:root=true
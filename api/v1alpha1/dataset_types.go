@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
+	"strconv"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -101,6 +104,13 @@ type Mount struct {
 	// +optional
 	Shared bool `json:"shared,omitempty"`
 
+	// VersionAt pins the mount to the state of a versioned object store (e.g. S3 or OSS
+	// with versioning enabled) at the given RFC3339 timestamp, so the engine resolves
+	// object versions as of that point in time during metadata sync. Only supported by
+	// engines and under storages with object versioning.
+	// +optional
+	VersionAt string `json:"versionAt,omitempty"`
+
 	// The secret information
 	// +optional
 	EncryptOptions []EncryptOption `json:"encryptOptions,omitempty"`
@@ -116,6 +126,26 @@ type DataRestoreLocation struct {
 	NodeName string `json:"nodeName,omitempty"`
 }
 
+// Networking holds the DNS and proxy settings propagated to the runtime components of
+// the dataset.
+type Networking struct {
+	// DNSConfig sets the pods' DNS parameters on top of the cluster DNS policy
+	// +optional
+	DNSConfig *v1.PodDNSConfig `json:"dnsConfig,omitempty"`
+
+	// HTTPProxy is injected as the HTTP_PROXY/HTTPS_PROXY environment of the components
+	// +optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// NoProxy is injected as the NO_PROXY environment of the components
+	// +optional
+	NoProxy string `json:"noProxy,omitempty"`
+
+	// HostAliases are appended to the pods' /etc/hosts
+	// +optional
+	HostAliases []v1.HostAlias `json:"hostAliases,omitempty"`
+}
+
 // DatasetSpec defines the desired state of Dataset
 type DatasetSpec struct {
 	// Mount Points to be mounted on Alluxio.
@@ -161,6 +191,105 @@ type DatasetSpec struct {
 	// SharedEncryptOptions is the encryptOption to all mount
 	// +optional
 	SharedEncryptOptions []EncryptOption `json:"sharedEncryptOptions,omitempty"`
+
+	// SharedLabels are labels propagated to the child resources created for the dataset,
+	// e.g. the cache pods and the PV/PVC, so that cost-allocation and policy tools can
+	// follow the dataset. Keys under the fluid.io/ prefix are reserved and dropped, and a
+	// shared label never overrides a label set by fluid or by the runtime.
+	// +optional
+	SharedLabels map[string]string `json:"sharedLabels,omitempty"`
+
+	// SharedAnnotations are annotations propagated to the child resources created for the
+	// dataset, with the same conflict handling as SharedLabels
+	// +optional
+	SharedAnnotations map[string]string `json:"sharedAnnotations,omitempty"`
+
+	// Networking customizes DNS and proxy settings of the pods accessing the dataset's
+	// under storage, for UFS behind corporate proxies or split-horizon DNS
+	// +optional
+	Networking *Networking `json:"networking,omitempty"`
+
+	// FileDefaults is the default permissions applied by the FUSE layer to newly
+	// created files and directories of a writable dataset
+	// +optional
+	FileDefaults *FileDefaults `json:"fileDefaults,omitempty"`
+
+	// DataMasking turns a dataset referencing another dataset (a dataset:// mount) into a
+	// de-identified view: paths matching the rules are masked in the fuse mount and the
+	// view is forced read-only, so analysts can work on the masked view while the raw
+	// dataset stays restricted. It has no effect on mounts of other schemes.
+	// +optional
+	DataMasking *DataMasking `json:"dataMasking,omitempty"`
+}
+
+// FileDefaults describes the default permissions of newly created files and directories
+// in a writable dataset. All values are octal strings, e.g. '0644'. Engines that only
+// support a creation umask derive it from Mode when Umask is not set.
+type FileDefaults struct {
+	// Mode is the default permission mode of newly created files
+	// +kubebuilder:validation:Pattern="^0?[0-7]{3}$"
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// DirMode is the default permission mode of newly created directories
+	// +kubebuilder:validation:Pattern="^0?[0-7]{3}$"
+	// +optional
+	DirMode string `json:"dirMode,omitempty"`
+
+	// Umask masks the permission bits of newly created files and directories
+	// +kubebuilder:validation:Pattern="^0?[0-7]{3}$"
+	// +optional
+	Umask string `json:"umask,omitempty"`
+}
+
+// EffectiveUmask returns the creation umask declared by the file defaults. When Umask is
+// not set explicitly, it falls back to the complement of Mode so that engines which only
+// support a umask still honor the declared file mode.
+func (f *FileDefaults) EffectiveUmask() string {
+	if f.Umask != "" {
+		return f.Umask
+	}
+	if f.Mode != "" {
+		if mode, err := strconv.ParseUint(f.Mode, 8, 32); err == nil {
+			return fmt.Sprintf("%03o", 0666&^mode)
+		}
+	}
+	return ""
+}
+
+// DataMaskingStrategy describes how a masked path is presented in the masked view
+type DataMaskingStrategy string
+
+const (
+	// HideMaskingStrategy makes the matched paths inaccessible in the masked view
+	HideMaskingStrategy DataMaskingStrategy = "hide"
+
+	// RedactMaskingStrategy keeps the matched paths readable but replaces their
+	// content with an empty placeholder
+	RedactMaskingStrategy DataMaskingStrategy = "redact"
+)
+
+// DataMaskingRule selects sensitive paths of the referenced dataset and how to mask them
+type DataMaskingRule struct {
+	// PathPattern is a glob pattern (filepath.Match syntax per path element) matched
+	// against paths relative to the dataset mount root, e.g. "raw/*/pii.csv"
+	// +required
+	PathPattern string `json:"pathPattern"`
+
+	// Strategy decides how matched paths show up in the masked view, defaults to hide
+	// +kubebuilder:validation:Enum=hide;redact;""
+	// +optional
+	Strategy DataMaskingStrategy `json:"strategy,omitempty"`
+}
+
+// DataMasking is the set of masking rules applied to a dataset referencing another dataset.
+// The rules are evaluated against the paths existing when an application pod mounts the
+// view, files written to the raw dataset afterwards are only covered on the next mount.
+type DataMasking struct {
+	// Rules select the sensitive paths and how they are masked
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Rules []DataMaskingRule `json:"rules"`
 }
 
 // Runtime describes a runtime to be used to support dataset
@@ -225,6 +354,15 @@ type DatasetStatus struct {
 
 	// DatasetRef specifies the datasets namespaced name mounting this Dataset.
 	DatasetRef []string `json:"datasetRef,omitempty"`
+
+	// BoundedTime records when the dataset first became bound to a runtime.
+	// +optional
+	BoundedTime *metav1.Time `json:"boundedTime,omitempty"`
+
+	// WarmedUpTime records when the cached percentage of the dataset first reached
+	// the warm-up threshold.
+	// +optional
+	WarmedUpTime *metav1.Time `json:"warmedUpTime,omitempty"`
 }
 
 // DatasetConditionType defines all kinds of types of cacheStatus.<br>
@@ -250,6 +388,14 @@ const (
 
 	// DatasetInitialized means the cache system for the dataset is Initialized.
 	DatasetInitialized DatasetConditionType = "Initialized"
+
+	// DatasetReconcileQuarantined means the dataset exhausted its reconcile error budget
+	// and is temporarily quarantined by the controller's circuit breaker.
+	DatasetReconcileQuarantined DatasetConditionType = "ReconcileQuarantined"
+
+	// DatasetConnectivityVerified reports the verdict of the opt-in connectivity
+	// self-test against the dataset's under storage.
+	DatasetConnectivityVerified DatasetConditionType = "ConnectivityVerified"
 )
 
 // CacheableNodeAffinity defines constraints that limit what nodes this dataset can be cached to.
@@ -128,6 +128,11 @@ type RuntimeStatus struct {
 
 	// MountPoints represents the mount points specified in the bounded dataset
 	Mounts []Mount `json:"mounts,omitempty"`
+
+	// EffectiveDefaults surfaces the layered cluster/namespace defaults this runtime
+	// inherited for the settings its spec leaves unset
+	// +optional
+	EffectiveDefaults map[string]string `json:"effectiveDefaults,omitempty"`
 }
 
 // OperationStatus defines the observed state of operation
@@ -175,6 +180,9 @@ const (
 	RuntimeFusesReady RuntimeConditionType = "FusesReady"
 	// RuntimeFusesScaledIn means the fuses of runtime just scaled in
 	RuntimeFusesScaledIn RuntimeConditionType = "FusesScaledIn"
+
+	// RuntimeFusesDegraded means some fuses of runtime exceeded their restart budget
+	RuntimeFusesDegraded RuntimeConditionType = "FusesDegraded"
 	// RuntimeFusesScaledOut means the fuses of runtime just scaled out
 	RuntimeFusesScaledOut RuntimeConditionType = "FusesScaledOut"
 )
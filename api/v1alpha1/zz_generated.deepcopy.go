@@ -24,6 +24,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -86,6 +87,11 @@ func (in *AlluxioCompTemplateSpec) DeepCopyInto(out *AlluxioCompTemplateSpec) {
 		}
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(ComponentUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlluxioCompTemplateSpec.
@@ -141,6 +147,11 @@ func (in *AlluxioFuseSpec) DeepCopyInto(out *AlluxioFuseSpec) {
 		}
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(ComponentUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlluxioFuseSpec.
@@ -221,6 +232,7 @@ func (in *AlluxioRuntimeSpec) DeepCopyInto(out *AlluxioRuntimeSpec) {
 	in.Worker.DeepCopyInto(&out.Worker)
 	in.JobWorker.DeepCopyInto(&out.JobWorker)
 	in.APIGateway.DeepCopyInto(&out.APIGateway)
+	in.NFSGateway.DeepCopyInto(&out.NFSGateway)
 	in.InitUsers.DeepCopyInto(&out.InitUsers)
 	in.Fuse.DeepCopyInto(&out.Fuse)
 	if in.Properties != nil {
@@ -250,6 +262,11 @@ func (in *AlluxioRuntimeSpec) DeepCopyInto(out *AlluxioRuntimeSpec) {
 		}
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.SecondaryNetwork != nil {
+		in, out := &in.SecondaryNetwork, &out.SecondaryNetwork
+		*out = new(SecondaryNetwork)
+		(*in).DeepCopyInto(*out)
+	}
 	in.RuntimeManagement.DeepCopyInto(&out.RuntimeManagement)
 	if in.MetadataList != nil {
 		in, out := &in.MetadataList, &out.MetadataList
@@ -290,6 +307,21 @@ func (in *CacheableNodeAffinity) DeepCopy() *CacheableNodeAffinity {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChecksumManifest) DeepCopyInto(out *ChecksumManifest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChecksumManifest.
+func (in *ChecksumManifest) DeepCopy() *ChecksumManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(ChecksumManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CleanCachePolicy) DeepCopyInto(out *CleanCachePolicy) {
 	*out = *in
@@ -315,6 +347,31 @@ func (in *CleanCachePolicy) DeepCopy() *CleanCachePolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentUpdateStrategy) DeepCopyInto(out *ComponentUpdateStrategy) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Partition != nil {
+		in, out := &in.Partition, &out.Partition
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentUpdateStrategy.
+func (in *ComponentUpdateStrategy) DeepCopy() *ComponentUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -347,6 +404,86 @@ func (in *Data) DeepCopy() *Data {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataArchive) DeepCopyInto(out *DataArchive) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataArchive.
+func (in *DataArchive) DeepCopy() *DataArchive {
+	if in == nil {
+		return nil
+	}
+	out := new(DataArchive)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataArchive) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataArchiveList) DeepCopyInto(out *DataArchiveList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataArchive, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataArchiveList.
+func (in *DataArchiveList) DeepCopy() *DataArchiveList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataArchiveList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataArchiveList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataArchiveSpec) DeepCopyInto(out *DataArchiveSpec) {
+	*out = *in
+	out.Dataset = in.Dataset
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataArchiveSpec.
+func (in *DataArchiveSpec) DeepCopy() *DataArchiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataArchiveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataBackup) DeepCopyInto(out *DataBackup) {
 	*out = *in
@@ -494,6 +631,11 @@ func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
 		*out = make([]TargetPath, len(*in))
 		copy(*out, *in)
 	}
+	if in.VerificationManifest != nil {
+		in, out := &in.VerificationManifest, &out.VerificationManifest
+		*out = new(ChecksumManifest)
+		**out = **in
+	}
 	if in.Options != nil {
 		in, out := &in.Options, &out.Options
 		*out = make(map[string]string, len(*in))
@@ -533,6 +675,41 @@ func (in *DataLoadSpec) DeepCopy() *DataLoadSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMasking) DeepCopyInto(out *DataMasking) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]DataMaskingRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMasking.
+func (in *DataMasking) DeepCopy() *DataMasking {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMasking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMaskingRule) DeepCopyInto(out *DataMaskingRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMaskingRule.
+func (in *DataMaskingRule) DeepCopy() *DataMaskingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMaskingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataMigrate) DeepCopyInto(out *DataMigrate) {
 	*out = *in
@@ -788,6 +965,35 @@ func (in *DatasetSpec) DeepCopyInto(out *DatasetSpec) {
 		*out = make([]EncryptOption, len(*in))
 		copy(*out, *in)
 	}
+	if in.SharedLabels != nil {
+		in, out := &in.SharedLabels, &out.SharedLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SharedAnnotations != nil {
+		in, out := &in.SharedAnnotations, &out.SharedAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Networking != nil {
+		in, out := &in.Networking, &out.Networking
+		*out = new(Networking)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileDefaults != nil {
+		in, out := &in.FileDefaults, &out.FileDefaults
+		*out = new(FileDefaults)
+		**out = **in
+	}
+	if in.DataMasking != nil {
+		in, out := &in.DataMasking, &out.DataMasking
+		*out = new(DataMasking)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSpec.
@@ -846,6 +1052,14 @@ func (in *DatasetStatus) DeepCopyInto(out *DatasetStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BoundedTime != nil {
+		in, out := &in.BoundedTime, &out.BoundedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.WarmedUpTime != nil {
+		in, out := &in.WarmedUpTime, &out.WarmedUpTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetStatus.
@@ -1085,6 +1299,21 @@ func (in *ExternalStorage) DeepCopy() *ExternalStorage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileDefaults) DeepCopyInto(out *FileDefaults) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileDefaults.
+func (in *FileDefaults) DeepCopy() *FileDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(FileDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GooseFSCompTemplateSpec) DeepCopyInto(out *GooseFSCompTemplateSpec) {
 	*out = *in
@@ -1840,6 +2069,11 @@ func (in *MetadataSyncPolicy) DeepCopyInto(out *MetadataSyncPolicy) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		*out = new(MetadataSyncSharding)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataSyncPolicy.
@@ -1852,6 +2086,31 @@ func (in *MetadataSyncPolicy) DeepCopy() *MetadataSyncPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataSyncSharding) DeepCopyInto(out *MetadataSyncSharding) {
+	*out = *in
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataSyncSharding.
+func (in *MetadataSyncSharding) DeepCopy() *MetadataSyncSharding {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataSyncSharding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Mount) DeepCopyInto(out *Mount) {
 	*out = *in
@@ -1879,6 +2138,33 @@ func (in *Mount) DeepCopy() *Mount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Networking) DeepCopyInto(out *Networking) {
+	*out = *in
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(v1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]v1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Networking.
+func (in *Networking) DeepCopy() *Networking {
+	if in == nil {
+		return nil
+	}
+	out := new(Networking)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSAdvise) DeepCopyInto(out *OSAdvise) {
 	*out = *in
@@ -2034,6 +2320,13 @@ func (in *RuntimeStatus) DeepCopyInto(out *RuntimeStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EffectiveDefaults != nil {
+		in, out := &in.EffectiveDefaults, &out.EffectiveDefaults
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeStatus.
@@ -2046,6 +2339,26 @@ func (in *RuntimeStatus) DeepCopy() *RuntimeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryNetwork) DeepCopyInto(out *SecondaryNetwork) {
+	*out = *in
+	if in.NetworkAttachmentDefinitions != nil {
+		in, out := &in.NetworkAttachmentDefinitions, &out.NetworkAttachmentDefinitions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryNetwork.
+func (in *SecondaryNetwork) DeepCopy() *SecondaryNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
 	*out = *in
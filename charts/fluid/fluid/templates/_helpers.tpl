@@ -69,3 +69,30 @@ Create the name of the service account to use
     {{ .Release.Namespace }}
 {{- end -}}
 {{- end -}}
+
+{{/*
+The host directory holding the Fluid csi socket. Defaults to a sub directory of the
+kubelet root dir, override csi.kubelet.pluginsDirPath for non-standard plugin dirs.
+*/}}
+{{- define "fluid.csi.pluginsDir" -}}
+{{- if .Values.csi.kubelet.pluginsDirPath -}}
+    {{ .Values.csi.kubelet.pluginsDirPath }}
+{{- else -}}
+    {{ .Values.csi.kubelet.rootDir }}/csi-plugins
+{{- end -}}
+{{- end -}}
+
+{{/*
+The host directory watched by kubelet for plugin registration sockets.
+*/}}
+{{- define "fluid.csi.registrationDir" -}}
+{{- if .Values.csi.kubelet.registrationDirPath -}}
+    {{ .Values.csi.kubelet.registrationDirPath }}
+{{- else -}}
+    {{ .Values.csi.kubelet.rootDir }}/plugins_registry
+{{- end -}}
+{{- end -}}
+
+{{- define "fluid.csi.socketPath" -}}
+{{ include "fluid.csi.pluginsDir" . }}/fuse.csi.fluid.io/csi.sock
+{{- end -}}
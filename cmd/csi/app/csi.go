@@ -31,6 +31,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/csi"
 	"github.com/fluid-cloudnative/fluid/pkg/csi/config"
 	utilfeature "github.com/fluid-cloudnative/fluid/pkg/utils/feature"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/loglevel"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -45,6 +46,8 @@ var (
 	pprofAddr   string
 	pruneFs     []string
 	prunePath   string
+
+	logLevelConfig string
 )
 
 var scheme = runtime.NewScheme()
@@ -81,6 +84,7 @@ func init() {
 	startCmd.Flags().StringVarP(&prunePath, "prune-path", "", "/runtime-mnt", "Prune path to add in /etc/updatedb.conf")
 	startCmd.Flags().StringVarP(&metricsAddr, "metrics-addr", "", ":8080", "The address the metrics endpoint binds to.")
 	startCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	startCmd.Flags().StringVarP(&logLevelConfig, "log-level-config", "", "", "Path to a log level config file (e.g. a mounted ConfigMap) whose \"v\" and \"vmodule\" entries are hot-reloaded to change glog verbosity without restarts, disabled when empty.")
 	utilfeature.DefaultMutableFeatureGate.AddFlag(startCmd.Flags())
 	startCmd.Flags().AddGoFlagSet(flag.CommandLine)
 }
@@ -94,6 +98,8 @@ func handle() {
 	// startReaper()
 	fluid.LogVersion()
 
+	loglevel.WatchGlogConfigFile(logLevelConfig, ctrl.Log.WithName("loglevel"))
+
 	if pprofAddr != "" {
 		newPprofServer(pprofAddr)
 	}
@@ -33,12 +33,22 @@ import (
 	"github.com/fluid-cloudnative/fluid"
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	databackupctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/databackup"
+	// importing the dataarchive package registers its reconciler builder so the
+	// operation gets set up together with the other custom data operations
+	_ "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataarchive"
 	dataloadctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataload"
 	datamigratectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datamigrate"
 	datasetctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataset"
+	"github.com/fluid-cloudnative/fluid/pkg/dataapi"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/externalmetrics"
+	"github.com/fluid-cloudnative/fluid/pkg/health"
+	"github.com/fluid-cloudnative/fluid/pkg/nodedrain"
+	"github.com/fluid-cloudnative/fluid/pkg/prewarm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/loglevel"
 )
 
 var (
@@ -53,6 +63,13 @@ var (
 	development             bool
 	pprofAddr               string
 	maxConcurrentReconciles int
+	dataAPIAddr             string
+	healthAPIAddr           string
+	externalMetricsAddr     string
+	externalMetricsCertDir  string
+	enableAutoPrewarm       bool
+	enableNodeDrain         bool
+	logLevelConfig          string
 )
 
 var datasetCmd = &cobra.Command{
@@ -72,16 +89,30 @@ func init() {
 	datasetCmd.Flags().StringVarP(&leaderElectionNamespace, "leader-election-namespace", "", "fluid-system", "The namespace in which the leader election resource will be created.")
 	datasetCmd.Flags().BoolVarP(&development, "development", "", true, "Enable development mode for fluid controller.")
 	datasetCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	datasetCmd.Flags().StringVarP(&dataAPIAddr, "data-api-addr", "", "", "The address the imperative dataset data API binds to, disabled when empty.")
+	datasetCmd.Flags().StringVarP(&healthAPIAddr, "health-api-addr", "", "", "The address the per-dataset health dashboard API binds to, disabled when empty.")
+	datasetCmd.Flags().StringVarP(&externalMetricsAddr, "external-metrics-addr", "", "", "The address the external metrics adapter for HPA binds to, disabled when empty.")
+	datasetCmd.Flags().StringVarP(&externalMetricsCertDir, "external-metrics-cert-dir", "", "/etc/fluid/external-metrics-certs", "The directory holding the TLS serving cert/key of the external metrics adapter; a self-signed cert is generated there when absent.")
+	datasetCmd.Flags().BoolVarP(&enableAutoPrewarm, "enable-auto-prewarm", "", false, "Enable pre-warm DataLoads scheduled from learned access patterns for opted-in datasets.")
+	datasetCmd.Flags().BoolVarP(&enableNodeDrain, "enable-node-drain", "", true, "Enable the node drainer handling the fluid.io/drain node annotation for maintenance workflows.")
 	datasetCmd.Flags().IntVar(&maxConcurrentReconciles, "reconcile-workers", 3, "Set the number of max concurrent workers for reconciling dataset and dataset operations")
+	datasetCmd.Flags().StringVarP(&logLevelConfig, "log-level-config", "", "", "Path to a log level config file (e.g. a mounted ConfigMap) that is hot-reloaded to change verbosity per module without restarts, disabled when empty.")
 }
 
 func handle() {
 	fluid.LogVersion()
 
+	defaultLevel := zapcore.InfoLevel
+	if development {
+		defaultLevel = zapcore.DebugLevel
+	}
+	logLevels := loglevel.NewController(defaultLevel, ctrl.Log.WithName("loglevel"))
+
 	ctrl.SetLogger(zap.New(func(o *zap.Options) {
 		o.Development = development
 	}, func(o *zap.Options) {
-		o.ZapOpts = append(o.ZapOpts, zapOpt.AddCaller())
+		o.Level = logLevels
+		o.ZapOpts = append(o.ZapOpts, zapOpt.AddCaller(), zapOpt.WrapCore(logLevels.WrapCore))
 	}, func(o *zap.Options) {
 		if !development {
 			encCfg := zapOpt.NewProductionEncoderConfig()
@@ -90,6 +121,7 @@ func handle() {
 			o.Encoder = zapcore.NewConsoleEncoder(encCfg)
 		}
 	}))
+	logLevels.WatchConfigFile(logLevelConfig)
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
@@ -148,6 +180,63 @@ func handle() {
 		os.Exit(1)
 	}
 
+	// Set up reconcilers for custom data operation types registered via the plugins registry
+	if err = dataoperation.ForEachOperationReconcilerBuilder(func(operationType dataoperation.OperationType, builder dataoperation.OperationReconcilerBuilder) error {
+		return builder(mgr.GetClient(),
+			ctrl.Log.WithName("dataoperationctl").WithName(string(operationType)),
+			mgr.GetScheme(),
+			mgr.GetEventRecorderFor(string(operationType)),
+		).SetupWithManager(mgr, controllerOptions)
+	}); err != nil {
+		setupLog.Error(err, "unable to create controller for registered data operations")
+		os.Exit(1)
+	}
+
+	// Optionally serve the imperative data API which proxies preload/evict/stats/flush
+	// to the engines without going through CRs
+	if dataAPIAddr != "" {
+		if err = mgr.Add(dataapi.NewServer(mgr.GetClient(), dataAPIAddr, ctrl.Log.WithName("dataapi"))); err != nil {
+			setupLog.Error(err, "unable to add the dataset data API server")
+			os.Exit(1)
+		}
+	}
+
+	// Optionally serve the health dashboard API rolling CSI, fuse and engine signals up
+	// into a per-dataset health score
+	if healthAPIAddr != "" {
+		if err = mgr.Add(health.NewServer(mgr.GetClient(), healthAPIAddr, ctrl.Log.WithName("health"))); err != nil {
+			setupLog.Error(err, "unable to add the health dashboard API server")
+			os.Exit(1)
+		}
+	}
+
+	// Optionally serve the external metrics adapter so HPAs can scale on data-path
+	// signals. It serves HTTPS since kube-aggregator only connects to APIService
+	// backends over TLS.
+	if externalMetricsAddr != "" {
+		if err = mgr.Add(externalmetrics.NewServer(mgr.GetClient(), externalMetricsAddr, externalMetricsCertDir, ctrl.Log.WithName("externalmetrics"))); err != nil {
+			setupLog.Error(err, "unable to add the external metrics adapter")
+			os.Exit(1)
+		}
+	}
+
+	// Optionally schedule pre-warm loads from learned access patterns, datasets still
+	// have to opt in with an annotation
+	if enableAutoPrewarm {
+		if err = mgr.Add(prewarm.NewPrewarmer(mgr.GetClient(), mgr.GetEventRecorderFor("AutoPrewarmer"), ctrl.Log.WithName("prewarm"))); err != nil {
+			setupLog.Error(err, "unable to add the auto prewarmer")
+			os.Exit(1)
+		}
+	}
+
+	// The drainer only acts on nodes annotated for drain, so it runs by default
+	if enableNodeDrain {
+		if err = mgr.Add(nodedrain.NewDrainer(mgr.GetClient(), mgr.GetEventRecorderFor("NodeDrainer"), ctrl.Log.WithName("nodedrain"))); err != nil {
+			setupLog.Error(err, "unable to add the node drainer")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting dataset-controller")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running dataset-controller")
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	controller "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/nodedrain"
+)
+
+var drainNodeName string
+
+func newDrainCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: "Prepare a node for maintenance before 'kubectl drain'",
+		Long: "Drain asks Fluid to stop new cache placements on a node and reports when " +
+			"no pod on the node mounts a cached dataset anymore, so the node can be " +
+			"drained with standard kubectl tooling without tearing down live fuse mounts.",
+	}
+	cmd.PersistentFlags().StringVar(&drainNodeName, "node", "", "name of the node to drain")
+	_ = cmd.MarkPersistentFlagRequired("node")
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "start",
+		Short: "Mark the node for drain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDrainStart()
+		},
+	}, &cobra.Command{
+		Use:   "status",
+		Short: "Report the readiness-for-drain of the node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDrainStatus()
+		},
+	}, &cobra.Command{
+		Use:   "stop",
+		Short: "Put the node back into regular cache placement",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDrainStop()
+		},
+	})
+	return cmd
+}
+
+func runDrainStart() error {
+	c, node, err := getNode()
+	if err != nil {
+		return err
+	}
+
+	current := node.DeepCopy()
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[common.AnnotationNodeDrain] = common.NodeDrainRequested
+	if err := c.Patch(context.TODO(), node, client.MergeFrom(current)); err != nil {
+		return err
+	}
+
+	fmt.Printf("node %s is marked for drain, new cache placements stop shortly\n", node.Name)
+	fmt.Printf("watch 'fluidctl drain status --node %s' and run 'kubectl drain %s' once it reports ready\n", node.Name, node.Name)
+	return nil
+}
+
+func runDrainStatus() error {
+	c, node, err := getNode()
+	if err != nil {
+		return err
+	}
+
+	if len(node.Annotations[common.AnnotationNodeDrain]) == 0 {
+		fmt.Printf("node %s is not marked for drain\n", node.Name)
+		return nil
+	}
+
+	blockers, err := nodedrain.ListDrainBlockers(context.TODO(), c, node.Name)
+	if err != nil {
+		return err
+	}
+
+	status := node.Annotations[common.AnnotationNodeDrainStatus]
+	if len(status) == 0 {
+		status = "pending"
+	}
+	fmt.Printf("node:     %s\n", node.Name)
+	fmt.Printf("status:   %s\n", status)
+	fmt.Printf("blockers: %s\n", nodedrain.DescribeBlockers(blockers))
+	if len(blockers) == 0 && status == common.NodeDrainStatusReady {
+		fmt.Printf("the node is ready for 'kubectl drain %s'\n", node.Name)
+	}
+	return nil
+}
+
+func runDrainStop() error {
+	c, node, err := getNode()
+	if err != nil {
+		return err
+	}
+
+	if len(node.Annotations[common.AnnotationNodeDrain]) == 0 {
+		fmt.Printf("node %s is not marked for drain\n", node.Name)
+		return nil
+	}
+
+	current := node.DeepCopy()
+	delete(node.Annotations, common.AnnotationNodeDrain)
+	if err := c.Patch(context.TODO(), node, client.MergeFrom(current)); err != nil {
+		return err
+	}
+
+	fmt.Printf("node %s resumes regular cache placement shortly\n", node.Name)
+	return nil
+}
+
+func getNode() (client.Client, *corev1.Node, error) {
+	c, err := client.New(controller.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, nil, err
+	}
+	node := &corev1.Node{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: drainNodeName}, node); err != nil {
+		return nil, nil, err
+	}
+	return c, node, nil
+}
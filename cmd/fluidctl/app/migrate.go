@@ -0,0 +1,215 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	controller "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+var (
+	migrateName        string
+	migrateNamespace   string
+	migrateRuntimeFile string
+	migrateWarm        bool
+	migrateTimeout     time.Duration
+)
+
+func newMigrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate a dataset to a runtime of another type",
+		Long: "Migrate guides a dataset from its current runtime to a new runtime declared " +
+			"in a manifest file (e.g. AlluxioRuntime to JuiceFSRuntime): it decommissions " +
+			"the old runtime, waits for the dataset to unbind, creates the new runtime, " +
+			"waits for the dataset to rebind with the same PV/PVC names so consumer pod " +
+			"specs stay valid, and optionally warms the new cache with a DataLoad. The " +
+			"migration is refused while pods still mount the dataset.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrate()
+		},
+	}
+	cmd.Flags().StringVar(&migrateName, "name", "", "name of the dataset to migrate")
+	cmd.Flags().StringVarP(&migrateNamespace, "namespace", "n", "default", "namespace of the dataset")
+	cmd.Flags().StringVar(&migrateRuntimeFile, "to-runtime-file", "", "path to a manifest declaring the new runtime for the dataset")
+	cmd.Flags().BoolVar(&migrateWarm, "warm", false, "create a DataLoad warming the whole dataset once the new runtime is bound")
+	cmd.Flags().DurationVar(&migrateTimeout, "timeout", 10*time.Minute, "how long to wait for each unbind/rebind step")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("to-runtime-file")
+	return cmd
+}
+
+func runMigrate() error {
+	c, err := client.New(controller.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return err
+	}
+
+	dataset, err := utils.GetDataset(c, migrateName, migrateNamespace)
+	if err != nil {
+		return err
+	}
+	if len(dataset.Status.Runtimes) == 0 {
+		return fmt.Errorf("dataset %s in namespace %s is not bound to any runtime, create the new runtime directly", migrateName, migrateNamespace)
+	}
+	oldType := dataset.Status.Runtimes[0].Type
+
+	newRuntime, err := decodeRuntimeManifest(migrateRuntimeFile)
+	if err != nil {
+		return err
+	}
+	if newRuntime.GetName() != dataset.Name || newRuntime.GetNamespace() != dataset.Namespace {
+		return fmt.Errorf("the new runtime must be named %s in namespace %s to bind the dataset, got %s in namespace %s",
+			dataset.Name, dataset.Namespace, newRuntime.GetName(), newRuntime.GetNamespace())
+	}
+
+	// refuse to tear the fuse mounts out from under running pods
+	mountNodes, err := kubeclient.GetPvcMountNodes(c, dataset.Name, dataset.Namespace)
+	if err != nil {
+		return err
+	}
+	if len(mountNodes) > 0 {
+		return fmt.Errorf("%d node(s) still run pods mounting dataset %s in namespace %s, stop the consumers before migrating",
+			len(mountNodes), migrateName, migrateNamespace)
+	}
+
+	runtimeInfo, err := base.GetRuntimeInfo(c, migrateName, migrateNamespace)
+	if err != nil {
+		return err
+	}
+	oldRuntime, err := getRuntime(c, runtimeInfo)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("decommissioning the old %s runtime for dataset %s/%s\n", oldType, migrateNamespace, migrateName)
+	if err := c.Delete(context.TODO(), oldRuntime); err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+	if err := waitForDatasetUnbound(c); err != nil {
+		return err
+	}
+
+	fmt.Printf("creating the new %s runtime\n", newRuntime.GetObjectKind().GroupVersionKind().Kind)
+	if err := c.Create(context.TODO(), newRuntime); err != nil {
+		return err
+	}
+	if err := waitForDatasetBound(c); err != nil {
+		return err
+	}
+	fmt.Printf("dataset %s/%s is bound to the new runtime\n", migrateNamespace, migrateName)
+
+	if migrateWarm {
+		dataLoad := &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-migrate-warm", migrateName),
+				Namespace: migrateNamespace,
+			},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{
+					Name:      migrateName,
+					Namespace: migrateNamespace,
+				},
+			},
+		}
+		if err := c.Create(context.TODO(), dataLoad); err != nil {
+			return err
+		}
+		fmt.Printf("created DataLoad %s/%s to warm the new cache\n", dataLoad.Namespace, dataLoad.Name)
+	}
+	return nil
+}
+
+// decodeRuntimeManifest reads a manifest file and decodes it into the fluid runtime
+// type declared by its kind
+func decodeRuntimeManifest(path string) (client.Object, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err := yaml.Unmarshal(content, &typeMeta); err != nil {
+		return nil, fmt.Errorf("can't read the kind of the runtime manifest %s: %v", path, err)
+	}
+
+	var obj client.Object
+	switch typeMeta.Kind {
+	case "AlluxioRuntime":
+		obj = &datav1alpha1.AlluxioRuntime{}
+	case "JindoRuntime":
+		obj = &datav1alpha1.JindoRuntime{}
+	case "GooseFSRuntime":
+		obj = &datav1alpha1.GooseFSRuntime{}
+	case "JuiceFSRuntime":
+		obj = &datav1alpha1.JuiceFSRuntime{}
+	case "ThinRuntime":
+		obj = &datav1alpha1.ThinRuntime{}
+	case "EACRuntime":
+		obj = &datav1alpha1.EACRuntime{}
+	default:
+		return nil, fmt.Errorf("manifest %s declares kind %q which is not a fluid runtime", path, typeMeta.Kind)
+	}
+	if err := yaml.UnmarshalStrict(content, obj); err != nil {
+		return nil, fmt.Errorf("can't decode the runtime manifest %s: %v", path, err)
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(migrateNamespace)
+	}
+	return obj, nil
+}
+
+// waitForDatasetUnbound waits until the old runtime is decommissioned and the dataset
+// no longer reports a bound runtime
+func waitForDatasetUnbound(c client.Client) error {
+	return wait.PollImmediate(2*time.Second, migrateTimeout, func() (bool, error) {
+		dataset, err := utils.GetDataset(c, migrateName, migrateNamespace)
+		if err != nil {
+			return false, err
+		}
+		if len(dataset.Status.Runtimes) > 0 || dataset.Status.Phase == datav1alpha1.BoundDatasetPhase {
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// waitForDatasetBound waits until the dataset is bound to the newly created runtime
+func waitForDatasetBound(c client.Client) error {
+	return wait.PollImmediate(2*time.Second, migrateTimeout, func() (bool, error) {
+		dataset, err := utils.GetDataset(c, migrateName, migrateNamespace)
+		if err != nil {
+			return false, err
+		}
+		return dataset.Status.Phase == datav1alpha1.BoundDatasetPhase, nil
+	})
+}
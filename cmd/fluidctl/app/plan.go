@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	controller "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+var (
+	scheme = runtime.NewScheme()
+
+	planName            string
+	planNamespace       string
+	planReplicas        int32
+	planWorkerName      string
+	planRewarmBandwidth string
+)
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = datav1alpha1.AddToScheme(scheme)
+}
+
+// NewFluidctlCommand builds the fluidctl root command.
+func NewFluidctlCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fluidctl",
+		Short: "fluidctl helps operate Fluid runtimes from the command line",
+	}
+	cmd.AddCommand(newPlanCommand())
+	cmd.AddCommand(newDrainCommand())
+	cmd.AddCommand(newMigrateCommand())
+	return cmd
+}
+
+func newPlanCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Simulate a worker replica change without applying it",
+		Long: "Plan reports which nodes would gain or lose workers, how much cached data " +
+			"would be dropped and an estimate of the time needed to re-warm it, " +
+			"without changing anything on the cluster.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPlan()
+		},
+	}
+	cmd.Flags().StringVar(&planName, "name", "", "name of the runtime to plan for")
+	cmd.Flags().StringVarP(&planNamespace, "namespace", "n", "default", "namespace of the runtime")
+	cmd.Flags().Int32Var(&planReplicas, "replicas", 0, "proposed worker replica count")
+	cmd.Flags().StringVar(&planWorkerName, "worker-statefulset", "", "name of the worker StatefulSet, defaults to <name>-worker")
+	cmd.Flags().StringVar(&planRewarmBandwidth, "rewarm-bandwidth", "200Mi", "assumed per-second re-warm bandwidth, e.g. 200Mi")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("replicas")
+	return cmd
+}
+
+func runPlan() error {
+	c, err := client.New(controller.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		return err
+	}
+
+	bandwidth, err := units.RAMInBytes(planRewarmBandwidth)
+	if err != nil {
+		return fmt.Errorf("can't parse rewarm-bandwidth %q: %v", planRewarmBandwidth, err)
+	}
+
+	runtimeInfo, err := base.GetRuntimeInfo(c, planName, planNamespace)
+	if err != nil {
+		return err
+	}
+	runtime, err := getRuntime(c, runtimeInfo)
+	if err != nil {
+		return err
+	}
+
+	workerName := planWorkerName
+	if len(workerName) == 0 {
+		workerName = planName + "-worker"
+	}
+	workers, err := kubeclient.GetStatefulSet(c, workerName, planNamespace)
+	if err != nil {
+		return err
+	}
+
+	helper := ctrl.BuildHelper(runtimeInfo, c, controller.Log.WithName("fluidctl"))
+	plan, err := helper.PlanWorkerScale(runtime, workers, planReplicas, bandwidth)
+	if err != nil {
+		return err
+	}
+
+	out, err := yaml.Marshal(plan)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+func getRuntime(c client.Client, runtimeInfo base.RuntimeInfoInterface) (base.RuntimeInterface, error) {
+	name, namespace := runtimeInfo.GetName(), runtimeInfo.GetNamespace()
+	switch runtimeInfo.GetRuntimeType() {
+	case common.AlluxioRuntime:
+		return utils.GetAlluxioRuntime(c, name, namespace)
+	case common.JindoRuntime:
+		return utils.GetJindoRuntime(c, name, namespace)
+	case common.GooseFSRuntime:
+		return utils.GetGooseFSRuntime(c, name, namespace)
+	case common.JuiceFSRuntime:
+		return utils.GetJuiceFSRuntime(c, name, namespace)
+	case common.ThinRuntime:
+		return utils.GetThinRuntime(c, name, namespace)
+	case common.EACRuntimeType:
+		return utils.GetEACRuntime(c, name, namespace)
+	default:
+		return nil, fmt.Errorf("unknown runtime type %s for %s in namespace %s", runtimeInfo.GetRuntimeType(), name, namespace)
+	}
+}
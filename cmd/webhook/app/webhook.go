@@ -36,6 +36,8 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ctrl/watch"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/loglevel"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/security"
 	fluidwebhook "github.com/fluid-cloudnative/fluid/pkg/webhook"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook/handler"
 )
@@ -50,12 +52,14 @@ var (
 )
 
 var (
-	development   bool
-	fullGoProfile bool
-	metricsAddr   string
-	webhookPort   int
-	certDir       string
-	pprofAddr     string
+	development    bool
+	fullGoProfile  bool
+	metricsAddr    string
+	webhookPort    int
+	certDir        string
+	certManagement string
+	pprofAddr      string
+	logLevelConfig string
 )
 
 var webhookCmd = &cobra.Command{
@@ -76,7 +80,9 @@ func init() {
 	webhookCmd.Flags().BoolVarP(&fullGoProfile, "full-go-profile", "", false, "Enable full golang profile mode for fluid controller.")
 	webhookCmd.Flags().IntVar(&webhookPort, "webhook-port", 9443, "Admission webhook listen address.")
 	webhookCmd.Flags().StringVar(&certDir, "webhook-cert-dir", "/etc/k8s-webhook-server/certs", "Admission webhook cert/key dir.")
+	webhookCmd.Flags().StringVar(&certManagement, "cert-management", "self-managed", "How the webhook certificate is managed, either \"self-managed\" for a built-in CA with automatic rotation, or \"cert-manager\" when cert-manager provisions the cert dir and injects the CA bundle.")
 	webhookCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	webhookCmd.Flags().StringVarP(&logLevelConfig, "log-level-config", "", "", "Path to a log level config file (e.g. a mounted ConfigMap) that is hot-reloaded to change verbosity per module without restarts, disabled when empty.")
 	webhookCmd.Flags().AddGoFlagSet(flag.CommandLine)
 }
 
@@ -85,10 +91,17 @@ func handle() {
 	// print fluid version
 	fluid.LogVersion()
 
+	defaultLevel := zapcore.InfoLevel
+	if development {
+		defaultLevel = zapcore.DebugLevel
+	}
+	logLevels := loglevel.NewController(defaultLevel, ctrl.Log.WithName("loglevel"))
+
 	ctrl.SetLogger(zap.New(func(o *zap.Options) {
 		o.Development = development
 	}, func(o *zap.Options) {
-		o.ZapOpts = append(o.ZapOpts, zapOpt.AddCaller())
+		o.Level = logLevels
+		o.ZapOpts = append(o.ZapOpts, zapOpt.AddCaller(), zapOpt.WrapCore(logLevels.WrapCore))
 	}, func(o *zap.Options) {
 		var encCfg zapcore.EncoderConfig
 		if !development {
@@ -100,6 +113,13 @@ func handle() {
 		encCfg.EncodeTime = zapcore.ISO8601TimeEncoder
 		o.Encoder = zapcore.NewConsoleEncoder(encCfg)
 	}))
+	logLevels.WatchConfigFile(logLevelConfig)
+
+	// refuse to start a non-compliant build when strict FIPS mode is requested
+	if err := security.VerifyStartup(setupLog); err != nil {
+		setupLog.Error(err, "FIPS startup verification failed")
+		os.Exit(1)
+	}
 
 	cfg, err := ctrl.GetConfig()
 	if err != nil {
@@ -131,6 +151,11 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if security.StrictFIPSEnabled() {
+		// pin the admission server to FIPS-approved protocol versions
+		mgr.GetWebhookServer().TLSMinVersion = security.MinTLSVersion
+	}
+
 	// get client from mgr
 	client, err := client.New(cfg, client.Options{})
 	if err != nil {
@@ -138,17 +163,34 @@ func handle() {
 		os.Exit(1)
 	}
 
-	// initialize the cert files
-	certBuilder := fluidwebhook.NewCertificateBuilder(client, setupLog)
-	caCert, err := certBuilder.BuildOrSyncCABundle(common.WebhookServiceName, certDir)
-	if err != nil || len(caCert) == 0 {
-		setupLog.Error(err, "initialize webhook CABundle failed")
-		os.Exit(1)
-	}
+	switch certManagement {
+	case "cert-manager":
+		// cert-manager provisions the cert files in the cert dir and its ca-injector
+		// patches the CA bundle, nothing to manage here
+		setupLog.Info("webhook certificate is managed by cert-manager, skip the built-in certificate management")
+	case "self-managed":
+		// initialize the cert files
+		certBuilder := fluidwebhook.NewCertificateBuilder(client, setupLog)
+		caCert, err := certBuilder.BuildOrSyncCABundle(common.WebhookServiceName, certDir)
+		if err != nil || len(caCert) == 0 {
+			setupLog.Error(err, "initialize webhook CABundle failed")
+			os.Exit(1)
+		}
 
-	// watch the WebhookConfiguration to patch it
-	if err = watch.SetupWatcherForWebhook(mgr, certBuilder, caCert); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "webhook")
+		// watch the WebhookConfiguration to patch it
+		if err = watch.SetupWatcherForWebhook(mgr, certBuilder); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "webhook")
+			os.Exit(1)
+		}
+
+		// rotate the cert before it expires, the webhook server reloads the rewritten
+		// cert files without a restart
+		if err = mgr.Add(fluidwebhook.NewCertificateRotator(certBuilder, common.WebhookName, common.WebhookServiceName, certDir)); err != nil {
+			setupLog.Error(err, "unable to add the webhook certificate rotator")
+			os.Exit(1)
+		}
+	default:
+		setupLog.Error(nil, "unknown cert management mode", "cert-management", certManagement)
 		os.Exit(1)
 	}
 
@@ -244,6 +244,7 @@ func (s *Injector) injectObject(pod common.FluidObject, pvcName string, runtimeI
 	option := common.FuseSidecarInjectOption{
 		EnableCacheDir:            utils.InjectCacheDirEnabled(metaObj.Labels),
 		EnableUnprivilegedSidecar: utils.FuseSidecarUnprivileged(metaObj.Labels),
+		CacheBypass:               utils.CacheBypassOf(metaObj.Annotations),
 	}
 
 	template, exist := cache.GetFuseTemplateByKey(pvcKey, option)
@@ -276,8 +277,13 @@ func (s *Injector) injectObject(pod common.FluidObject, pvcName string, runtimeI
 		return err
 	}
 
-	// 4.a Set mount propagation to existing containers
-	// 4.b Add app postStart script to check fuse mount point(i.e. volumeMount.Path) ready
+	// 4.a Share the dataset volume mounts with the app containers not declaring the PVC
+	// when the pod opts in, so multi-container pods see the dataset everywhere
+	if utils.ShareMountEnabled(metaObj.Labels) {
+		containers = shareVolumeMounts(containers, datasetVolumeNames)
+	}
+
+	// 4.b Set mount propagation to existing containers
 	containers, needInjection := mutateVolumeMounts(containers, datasetVolumeNames)
 	// 4.c Add fuse container to First
 	if needInjection {
@@ -37,3 +37,42 @@ func mutateVolumeMounts(containers []corev1.Container, datasetVolumeNames []stri
 
 	return containers, needInjection
 }
+
+// shareVolumeMounts copies the dataset volume mounts declared by any container into the
+// containers lacking them, with HostToContainer propagation, so that every container of a
+// multi-container pod sees the dataset through the shared volume instead of only the
+// containers declaring the PVC.
+func shareVolumeMounts(containers []corev1.Container, datasetVolumeNames []string) []corev1.Container {
+	mountPropagationHostToContainer := corev1.MountPropagationHostToContainer
+
+	// take the first declaration of each dataset volume as the canonical mount
+	canonicalMounts := map[string]corev1.VolumeMount{}
+	for _, container := range containers {
+		for _, volumeMount := range container.VolumeMounts {
+			if !utils.ContainsString(datasetVolumeNames, volumeMount.Name) {
+				continue
+			}
+			if _, found := canonicalMounts[volumeMount.Name]; !found {
+				canonicalMounts[volumeMount.Name] = volumeMount
+			}
+		}
+	}
+
+	for ci := range containers {
+		mounted := map[string]bool{}
+		for _, volumeMount := range containers[ci].VolumeMounts {
+			mounted[volumeMount.Name] = true
+		}
+		for _, volumeName := range datasetVolumeNames {
+			canonical, found := canonicalMounts[volumeName]
+			if !found || mounted[volumeName] {
+				continue
+			}
+			volumeMount := canonical.DeepCopy()
+			volumeMount.MountPropagation = &mountPropagationHostToContainer
+			containers[ci].VolumeMounts = append(containers[ci].VolumeMounts, *volumeMount)
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuse
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestShareVolumeMounts(t *testing.T) {
+	containers := []corev1.Container{
+		{
+			Name: "model-server",
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "dataset", MountPath: "/data"},
+			},
+		},
+		{
+			Name: "preprocessor",
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: "scratch", MountPath: "/scratch"},
+			},
+		},
+		{
+			Name: "metrics",
+		},
+	}
+
+	got := shareVolumeMounts(containers, []string{"dataset"})
+
+	for _, container := range got {
+		var datasetMount *corev1.VolumeMount
+		for i, volumeMount := range container.VolumeMounts {
+			if volumeMount.Name == "dataset" {
+				datasetMount = &container.VolumeMounts[i]
+			}
+		}
+		if datasetMount == nil {
+			t.Errorf("expect container %s to mount the dataset volume, got %v", container.Name, container.VolumeMounts)
+			continue
+		}
+		if datasetMount.MountPath != "/data" {
+			t.Errorf("expect container %s to mount the dataset at the declared path /data, got %s", container.Name, datasetMount.MountPath)
+		}
+	}
+
+	// the declaring container keeps its original mount untouched
+	if got[0].VolumeMounts[0].MountPropagation != nil {
+		t.Errorf("expect the declaring container's mount to be left for mutateVolumeMounts, got %v", got[0].VolumeMounts[0].MountPropagation)
+	}
+	// the copied mounts already carry HostToContainer propagation
+	for _, container := range got[1:] {
+		for _, volumeMount := range container.VolumeMounts {
+			if volumeMount.Name != "dataset" {
+				continue
+			}
+			if volumeMount.MountPropagation == nil || *volumeMount.MountPropagation != corev1.MountPropagationHostToContainer {
+				t.Errorf("expect the shared mount of container %s to use HostToContainer propagation, got %v", container.Name, volumeMount.MountPropagation)
+			}
+		}
+	}
+}
@@ -49,6 +49,8 @@ const (
 
 	FuseRecoverFailed = "FuseRecoverFailed"
 
+	FuseMountFailed = "FuseMountFailed"
+
 	FuseRecoverSucceed = "FuseRecoverSucceed"
 
 	RuntimeDeprecated = "RuntimeDeprecated"
@@ -70,6 +72,82 @@ const (
 	DataOperationNotValid = "DataOperationNotValid"
 
 	DataOperationCollision = "DataOperationCollision"
+
+	DataOperationCostAckPending = "CostAckPending"
+
+	DatasetTransferStarted = "DatasetTransferStarted"
+
+	DatasetTransferFailed = "DatasetTransferFailed"
+
+	DatasetTransferComplete = "DatasetTransferComplete"
+
+	DatasetSLOViolated = "DatasetSLOViolated"
+
+	RuntimePropertyDeprecated = "RuntimePropertyDeprecated"
+
+	RuntimeWorkersUnschedulable = "WorkersUnschedulable"
+
+	RuntimeWorkerNodeLost = "WorkerNodeLost"
+
+	RuntimeCacheStateRefreshed = "CacheStateRefreshed"
+
+	ScratchDatasetReclaimed = "ScratchDatasetReclaimed"
+
+	ConnectivityCheckStarted = "ConnectivityCheckStarted"
+
+	ConnectivityCheckSucceeded = "ConnectivityCheckSucceeded"
+
+	ConnectivityCheckFailed = "ConnectivityCheckFailed"
+
+	ScratchDatasetPersisting = "ScratchDatasetPersisting"
+
+	AccessModeTransitioned = "AccessModeTransitioned"
+
+	AccessModeTransitionBlocked = "AccessModeTransitionBlocked"
+
+	ReconcileQuarantined = "ReconcileQuarantined"
+
+	ReconcileQuarantineReset = "ReconcileQuarantineReset"
+
+	FuseRestartBudgetExceeded = "FuseRestartBudgetExceeded"
+
+	AutoPrewarmScheduled = "AutoPrewarmScheduled"
+
+	NodeDrainReady = "NodeDrainReady"
+)
+
+// The valid values of the cache bypass annotation
+const (
+	CacheBypassWrite = "write"
+
+	CacheBypassAll = "all"
+)
+
+// The valid values of the chaos action annotation
+const (
+	ChaosActionKillFuse = "kill-fuse"
+
+	ChaosActionDropUfs = "drop-ufs"
+
+	ChaosActionCorruptMount = "corrupt-mount"
+
+	ChaosActionRestore = "restore"
+)
+
+// The valid values of the node drain annotations
+const (
+	NodeDrainRequested = "requested"
+
+	NodeDrainStatusDraining = "draining"
+
+	NodeDrainStatusReady = "ready"
+)
+
+// The valid values of the recreate cache policy annotation
+const (
+	RecreateCachePolicyReuse = "reuse"
+
+	RecreateCachePolicyColdStart = "cold-start"
 )
 
 type CacheStoreType string
@@ -147,6 +225,7 @@ const (
 	InjectFuseSidecar             = "fuse" + injectSidecar
 	InjectUnprivilegedFuseSidecar = "unprivileged" + injectSidecar
 	InjectCacheDir                = "cachedir" + injectSidecar
+	InjectShareMount              = "sharemount" + injectSidecar
 	InjectWorkerSidecar           = "worker" + injectSidecar
 	InjectSidecarDone             = "done" + injectSidecar
 	App                           = "app"
@@ -164,3 +243,7 @@ const (
 	PodRoleType = "role"
 	DataloadPod = "dataload-pod"
 )
+
+// AnnotationMultusNetworks is the Multus annotation used to attach pods to
+// secondary networks defined by NetworkAttachmentDefinitions
+const AnnotationMultusNetworks = "k8s.v1.cni.cncf.io/networks"
@@ -44,6 +44,121 @@ const (
 	LabelAnnotationDatasetReferringNameSpace = LabelAnnotationDataset + ".referring-namespace"
 
 	RuntimeControllerReplicas = "controller.runtime." + LabelAnnotationPrefix + "replicas"
+
+	// AnnotationDatasetTransferToNamespace asks the dataset controller to recreate the
+	// dataset and its bound runtime in the given namespace, format: 'fluid.io/dataset.transfer-to-namespace'
+	AnnotationDatasetTransferToNamespace = LabelAnnotationDataset + ".transfer-to-namespace"
+	// AnnotationDatasetTransferredFrom records the source '{namespace}/{name}' on objects
+	// recreated by a dataset transfer
+	AnnotationDatasetTransferredFrom = LabelAnnotationDataset + ".transferred-from"
+
+	// AnnotationDatasetBoundSLO declares the target duration between dataset creation and
+	// the dataset becoming bound, e.g. '10m'. A violation event is emitted when exceeded.
+	AnnotationDatasetBoundSLO = LabelAnnotationDataset + ".bound-slo"
+	// AnnotationDatasetWarmUpSLO declares the target duration between dataset creation and
+	// the cached percentage reaching the warm-up threshold, e.g. '30m'
+	AnnotationDatasetWarmUpSLO = LabelAnnotationDataset + ".warmup-slo"
+	// AnnotationDatasetWarmUpSLOPercent overrides the cached percentage threshold
+	// regarded as warmed up, defaults to 80
+	AnnotationDatasetWarmUpSLOPercent = LabelAnnotationDataset + ".warmup-slo-percent"
+
+	// AnnotationSkipPropertyValidation opts a runtime out of the validation of its
+	// free-form properties against the engine's known option groups
+	AnnotationSkipPropertyValidation = LabelAnnotationPrefix + "skip-property-validation"
+
+	// AnnotationCircuitBreakerReset asks the controller to close the reconcile circuit
+	// breaker for a quarantined object, the annotation is removed once handled
+	AnnotationCircuitBreakerReset = LabelAnnotationPrefix + "circuit-breaker-reset"
+
+	// AnnotationAutoPrewarm opts a dataset into pre-warm loads scheduled from learned
+	// access patterns, also used as a marker label on the created DataLoads
+	AnnotationAutoPrewarm = LabelAnnotationPrefix + "auto-prewarm"
+
+	// AnnotationPrewarmDecision records on a pre-warm DataLoad why it was scheduled
+	AnnotationPrewarmDecision = LabelAnnotationPrefix + "prewarm-decision"
+
+	// AnnotationLocalityHints mirrors the cache locality preferences injected into an
+	// operator-managed pod, so the operator's own scheduler can consume them
+	AnnotationLocalityHints = LabelAnnotationPrefix + "locality-hints"
+
+	// AnnotationDatasetEphemeralScratch marks a dataset as managed ephemeral scratch
+	// space whose lifecycle is bound to a workload instead of lasting forever
+	AnnotationDatasetEphemeralScratch = LabelAnnotationDataset + ".ephemeral-scratch"
+
+	// AnnotationDatasetScratchOwnerJob names the job in the dataset's namespace that an
+	// ephemeral scratch dataset binds its lifecycle to
+	AnnotationDatasetScratchOwnerJob = LabelAnnotationDataset + ".scratch-owner-job"
+
+	// AnnotationDatasetScratchPersistTo declares an external storage URI the scratch
+	// data is migrated to before the dataset is reclaimed
+	AnnotationDatasetScratchPersistTo = LabelAnnotationDataset + ".scratch-persist-to"
+
+	// AnnotationDatasetConnectivityCheck opts the dataset into a one-shot connectivity
+	// self-test against its s3:// mount before the runtime is brought up
+	AnnotationDatasetConnectivityCheck = LabelAnnotationDataset + ".connectivity-check"
+
+	// AnnotationDatasetRecreateCachePolicy controls what happens to the on-disk cache
+	// directories when the dataset is deleted. 'reuse' keeps them so that a recreated
+	// dataset with the same runtime adopts the warmed cache, 'cold-start' (the default)
+	// wipes them
+	AnnotationDatasetRecreateCachePolicy = LabelAnnotationDataset + ".recreate-cache-policy"
+
+	// AnnotationNodeDrain marks a node for cache drain ahead of maintenance. The node
+	// drainer stops new cache placements on the node and reports readiness back via
+	// the drain status annotation, so the drain slots in before a standard
+	// 'kubectl drain'
+	AnnotationNodeDrain = LabelAnnotationPrefix + "drain"
+
+	// AnnotationNodeDrainStatus reports the drain progress on the node, either
+	// "draining" while consumer pods still mount cached datasets or "ready"
+	AnnotationNodeDrainStatus = LabelAnnotationPrefix + "drain-status"
+
+	// LabelNodeDraining excludes a draining node from new cache placements
+	LabelNodeDraining = LabelAnnotationPrefix + "node-draining"
+
+	// AnnotationCacheBypass lets a pod route its IOs against the dataset around the
+	// cache, either 'write' (writes go straight to the UFS) or 'all' (reads skip
+	// caching too), so one-off bulk accesses don't evict hot read data
+	AnnotationCacheBypass = LabelAnnotationPrefix + "cache-bypass"
+
+	// AnnotationPackingStrategy picks how a shared-placement dataset's workers are packed
+	// over the cache nodes, either 'binpack' (the default) or 'spread'
+	AnnotationPackingStrategy = LabelAnnotationPrefix + "packing-strategy"
+
+	// AnnotationDataOperationCostAck acknowledges the estimated egress cost of a data
+	// operation whose spec sets requireCostAck, letting the operation leave Pending
+	AnnotationDataOperationCostAck = LabelAnnotationPrefix + "cost-ack"
+
+	// LabelNodeIdmappedMounts advertises that the node's kernel supports idmapped
+	// bind mounts, so Fluid volumes on this node work for pods running with user
+	// namespaces. Patched onto the node by the CSI plugin
+	LabelNodeIdmappedMounts = LabelAnnotationPrefix + "node-idmapped-mounts"
+
+	// AnnotationUsernsMapping carries the ID mapping of a pod running with user
+	// namespaces, format '{containerID}:{hostID}:{length}[,...]', e.g. '0:100000:65536'.
+	// Kubelet does not hand the runtime-chosen mapping to CSI drivers, so the CSI
+	// plugin reads it from this pod annotation when idmapping a published mount
+	AnnotationUsernsMapping = LabelAnnotationPrefix + "userns-mapping"
+
+	// AnnotationChaosAction requests a chaos fault injection on the node, one of
+	// 'kill-fuse', 'drop-ufs', 'corrupt-mount' or 'restore'. Only honored when the
+	// MountChaos feature gate is on, meant for resilience tests and failure runbook
+	// validation in staging clusters
+	AnnotationChaosAction = LabelAnnotationPrefix + "chaos-action"
+
+	// AnnotationChaosDataset names the '{namespace}/{name}' dataset the chaos action
+	// targets
+	AnnotationChaosDataset = LabelAnnotationPrefix + "chaos-dataset"
+
+	// AnnotationChaosStatus reports the outcome of the last chaos action on the node
+	AnnotationChaosStatus = LabelAnnotationPrefix + "chaos-status"
+
+	// LabelAnnotationStorageMediaPrefix labels nodes with the storage media discovered
+	// on them, format: 'fluid.io/node-media-{medium}', e.g. 'fluid.io/node-media-ssd'
+	LabelAnnotationStorageMediaPrefix = LabelAnnotationPrefix + "node-media-"
+	// AnnotationStorageMediaCapacitySuffix suffixes the media label to annotate nodes
+	// with the free bytes of the medium, e.g. 'fluid.io/node-media-ssd.capacity'
+	AnnotationStorageMediaCapacitySuffix = ".capacity"
 )
 
 var (
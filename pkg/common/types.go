@@ -125,6 +125,9 @@ const (
 	Complete ConditionType = "Complete"
 	// Failed means the task has failed its execution.
 	Failed ConditionType = "Failed"
+	// Waiting means the task can not start yet and is waiting for a blocking
+	// precondition to resolve, see the condition's reason and message for details.
+	Waiting ConditionType = "Waiting"
 )
 
 type OwnerReference struct {
@@ -160,12 +163,14 @@ type FuseInjectionTemplate struct {
 type FuseSidecarInjectOption struct {
 	EnableCacheDir            bool
 	EnableUnprivilegedSidecar bool
+	CacheBypass               string
 }
 
 func (f FuseSidecarInjectOption) String() string {
-	return fmt.Sprintf("EnableCacheDir=%v;EnableUnprivilegedSidecar=%v",
+	return fmt.Sprintf("EnableCacheDir=%v;EnableUnprivilegedSidecar=%v;CacheBypass=%v",
 		f.EnableCacheDir,
-		f.EnableUnprivilegedSidecar)
+		f.EnableUnprivilegedSidecar,
+		f.CacheBypass)
 }
 
 // The Application which is using Fluid,
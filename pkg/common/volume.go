@@ -5,6 +5,10 @@ const (
 
 	VolumeAttrFluidSubPath = "fluid_sub_path"
 
+	// VolumeAttrFluidMaskedPaths carries the JSON-encoded data masking rules of a
+	// virtual dataset, enforced by the CSI plugin when publishing the volume
+	VolumeAttrFluidMaskedPaths = "fluid_masked_paths"
+
 	VolumeAttrMountType = "mount_type"
 
 	VolumeAttrNamespace = "runtime_namespace"
@@ -25,6 +25,7 @@ const (
 	WebhookName            = "fluid-pod-admission-webhook"
 	WebhookServiceName     = "fluid-pod-admission-webhook"
 	WebhookSchedulePodPath = "mutate-fluid-io-v1alpha1-schedulepod"
+	WebhookWarnRuntimePath = "warn-fluid-io-v1alpha1-runtime"
 
 	CertSecretName = "fluid-webhook-certs"
 )
@@ -0,0 +1,152 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	// ReconcileErrorBudgetEnv overrides how many consecutive reconcile failures an object
+	// may accumulate before the circuit breaker quarantines it
+	ReconcileErrorBudgetEnv = "RECONCILE_ERROR_BUDGET"
+
+	// ReconcileQuarantineBaseBackoffEnv overrides the first quarantine period, which
+	// doubles on every further failure up to the cap
+	ReconcileQuarantineBaseBackoffEnv = "RECONCILE_QUARANTINE_BASE_BACKOFF"
+
+	// ReconcileQuarantineMaxBackoffEnv overrides the longest quarantine period
+	ReconcileQuarantineMaxBackoffEnv = "RECONCILE_QUARANTINE_MAX_BACKOFF"
+
+	defaultReconcileErrorBudget    = 10
+	defaultQuarantineBaseBackoff   = 5 * time.Minute
+	defaultQuarantineMaxBackoff    = 24 * time.Hour
+	quarantineEntryExpireThreshold = 48 * time.Hour
+)
+
+// ReconcileCircuitBreaker quarantines objects whose reconciles keep failing or panicking,
+// so a single malformed object can't keep a controller's worker pool busy with hopeless
+// retries. After an object exhausts its error budget it is put in quarantine with an
+// exponentially growing, very long backoff; a successful reconcile or a manual reset
+// closes the circuit again.
+type ReconcileCircuitBreaker struct {
+	mutex   sync.Mutex
+	entries map[string]*circuitBreakerEntry
+
+	errorBudget int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+}
+
+type circuitBreakerEntry struct {
+	consecutiveFailures int
+	openUntil           time.Time
+	lastFailure         time.Time
+}
+
+// NewReconcileCircuitBreaker builds a circuit breaker with defaults that can be tuned
+// through environment variables.
+func NewReconcileCircuitBreaker() *ReconcileCircuitBreaker {
+	errorBudget := defaultReconcileErrorBudget
+	if value, found := utils.GetIntValueFormEnv(ReconcileErrorBudgetEnv); found && value > 0 {
+		errorBudget = value
+	}
+
+	return &ReconcileCircuitBreaker{
+		entries:     map[string]*circuitBreakerEntry{},
+		errorBudget: errorBudget,
+		baseBackoff: utils.GetDurationValueFromEnv(ReconcileQuarantineBaseBackoffEnv, defaultQuarantineBaseBackoff),
+		maxBackoff:  utils.GetDurationValueFromEnv(ReconcileQuarantineMaxBackoffEnv, defaultQuarantineMaxBackoff),
+	}
+}
+
+// Quarantined reports whether the object is currently quarantined and, if so, how long
+// to wait before looking at it again.
+func (c *ReconcileCircuitBreaker) Quarantined(key string) (open bool, backoff time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, found := c.entries[key]
+	if !found {
+		return false, 0
+	}
+
+	remaining := time.Until(entry.openUntil)
+	if remaining <= 0 {
+		// the quarantine period is over, allow one probing reconcile
+		return false, 0
+	}
+
+	return true, remaining
+}
+
+// RecordFailure counts a failed or panicked reconcile against the object's error budget.
+// It reports whether this failure tripped the breaker open and the backoff to use.
+func (c *ReconcileCircuitBreaker) RecordFailure(key string) (quarantined bool, backoff time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.expireStaleEntriesLocked()
+
+	entry, found := c.entries[key]
+	if !found {
+		entry = &circuitBreakerEntry{}
+		c.entries[key] = entry
+	}
+	entry.consecutiveFailures++
+	entry.lastFailure = time.Now()
+
+	if entry.consecutiveFailures < c.errorBudget {
+		return false, 0
+	}
+
+	backoff = c.baseBackoff
+	for i := entry.consecutiveFailures - c.errorBudget; i > 0 && backoff < c.maxBackoff; i-- {
+		backoff *= 2
+	}
+	if backoff > c.maxBackoff {
+		backoff = c.maxBackoff
+	}
+	entry.openUntil = time.Now().Add(backoff)
+
+	return true, backoff
+}
+
+// RecordSuccess closes the circuit for the object after a clean reconcile.
+func (c *ReconcileCircuitBreaker) RecordSuccess(key string) {
+	c.Reset(key)
+}
+
+// Reset drops all failure accounting for the object, e.g. on a manual reset.
+func (c *ReconcileCircuitBreaker) Reset(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, key)
+}
+
+// expireStaleEntriesLocked drops accounting for objects that haven't failed for a long
+// time so deleted objects don't pile up. Callers must hold the mutex.
+func (c *ReconcileCircuitBreaker) expireStaleEntriesLocked() {
+	for key, entry := range c.entries {
+		if time.Since(entry.lastFailure) > quarantineEntryExpireThreshold {
+			delete(c.entries, key)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReconcileCircuitBreaker(t *testing.T) {
+	breaker := &ReconcileCircuitBreaker{
+		entries:     map[string]*circuitBreakerEntry{},
+		errorBudget: 3,
+		baseBackoff: time.Minute,
+		maxBackoff:  4 * time.Minute,
+	}
+	key := "fluid/hbase"
+
+	// failures within the error budget must not trip the breaker
+	for i := 0; i < 2; i++ {
+		if quarantined, _ := breaker.RecordFailure(key); quarantined {
+			t.Fatalf("expect the breaker to stay closed within the error budget, tripped at failure %d", i+1)
+		}
+	}
+	if open, _ := breaker.Quarantined(key); open {
+		t.Errorf("expect the object not to be quarantined within the error budget")
+	}
+
+	// the failure exhausting the budget trips the breaker with the base backoff
+	quarantined, backoff := breaker.RecordFailure(key)
+	if !quarantined || backoff != time.Minute {
+		t.Errorf("expect the breaker to trip with the base backoff, got quarantined %v backoff %v", quarantined, backoff)
+	}
+	if open, _ := breaker.Quarantined(key); !open {
+		t.Errorf("expect the object to be quarantined after exhausting the error budget")
+	}
+
+	// further failures back off exponentially up to the cap
+	if _, backoff = breaker.RecordFailure(key); backoff != 2*time.Minute {
+		t.Errorf("expect the backoff to double, got %v", backoff)
+	}
+	for i := 0; i < 5; i++ {
+		_, backoff = breaker.RecordFailure(key)
+	}
+	if backoff != 4*time.Minute {
+		t.Errorf("expect the backoff to be capped at 4m, got %v", backoff)
+	}
+
+	// a success or a manual reset closes the circuit again
+	breaker.RecordSuccess(key)
+	if open, _ := breaker.Quarantined(key); open {
+		t.Errorf("expect the circuit to close after a successful reconcile")
+	}
+	if quarantined, _ := breaker.RecordFailure(key); quarantined {
+		t.Errorf("expect the error budget to restart from zero after a reset")
+	}
+
+	// unrelated objects are accounted independently
+	if open, _ := breaker.Quarantined("fluid/spark"); open {
+		t.Errorf("expect an unrelated object not to be quarantined")
+	}
+}
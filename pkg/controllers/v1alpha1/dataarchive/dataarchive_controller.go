@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataarchive
+
+import (
+	"context"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	cdataarchive "github.com/fluid-cloudnative/fluid/pkg/dataarchive"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+const controllerName string = "DataArchiveController"
+
+func init() {
+	if err := dataoperation.RegisterOperationReconcilerBuilder(dataoperation.DataArchive,
+		func(client client.Client, log logr.Logger, scheme *runtime.Scheme, recorder record.EventRecorder) dataoperation.OperationReconcilerInterface {
+			return NewDataArchiveReconciler(client, log, scheme, recorder)
+		}); err != nil {
+		panic(err)
+	}
+}
+
+// DataArchiveReconciler reconciles a DataArchive object
+type DataArchiveReconciler struct {
+	Scheme *runtime.Scheme
+	*controllers.OperationReconciler
+}
+
+// NewDataArchiveReconciler returns a DataArchiveReconciler
+func NewDataArchiveReconciler(client client.Client,
+	log logr.Logger,
+	scheme *runtime.Scheme,
+	recorder record.EventRecorder) *DataArchiveReconciler {
+	r := &DataArchiveReconciler{
+		Scheme: scheme,
+	}
+	r.OperationReconciler = controllers.NewDataOperationReconciler(r, client, log, recorder)
+	return r
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataarchives,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataarchives/status,verbs=get;update;patch
+// Reconcile reconciles the DataArchive object
+func (r *DataArchiveReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx := dataoperation.ReconcileRequestContext{
+		// used for create engine
+		ReconcileRequestContext: cruntime.ReconcileRequestContext{
+			Context:  context,
+			Log:      r.Log.WithValues(string(r.GetOperationType()), req.NamespacedName),
+			Recorder: r.Recorder,
+			Client:   r.Client,
+			Category: common.AccelerateCategory,
+		},
+		DataOpFinalizerName: cdataarchive.Finalizer,
+	}
+	// 1. Get DataArchive object
+	dataArchive, err := utils.GetDataArchive(r.Client, req.Name, req.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			ctx.Log.Info("DataArchive not found")
+			return utils.NoRequeue()
+		} else {
+			ctx.Log.Error(err, "failed to get DataArchive")
+			return utils.RequeueIfError(errors.Wrap(err, "failed to get DataArchive info"))
+		}
+	}
+	ctx.DataObject = dataArchive
+	ctx.OpStatus = &dataArchive.Status
+
+	return r.ReconcileInternal(ctx)
+}
+
+// SetupWithManager sets up the controller with the given controller manager
+func (r *DataArchiveReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataArchive{}).
+		Complete(r)
+}
+
+func (r *DataArchiveReconciler) ControllerName() string {
+	return controllerName
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataarchive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataarchive "github.com/fluid-cloudnative/fluid/pkg/dataarchive"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateStatusByHelmStatus update the operation status according to the archive pod status
+func (r *DataArchiveReconciler) UpdateStatusByHelmStatus(ctx runtime.ReconcileRequestContext, object client.Object, opStatus *v1alpha1.OperationStatus) (err error) {
+	// 1. get the archive pod
+	archivePodName := utils.GetDataArchivePodName(object.GetName())
+	archivePod, err := kubeclient.GetPodByName(ctx.Client, archivePodName, object.GetNamespace())
+	if err != nil {
+		ctx.Log.Error(err, "Failed to get dataarchive-pod")
+		return
+	}
+
+	// 2. only update status if finished
+	if !kubeclient.IsFinishedPod(archivePod) {
+		return
+	}
+
+	var finishTime time.Time
+	if len(archivePod.Status.Conditions) != 0 {
+		finishTime = archivePod.Status.Conditions[0].LastTransitionTime.Time
+	} else {
+		// fail to get finishTime, use current time as default
+		finishTime = time.Now()
+	}
+	opStatus.Duration = utils.CalculateDuration(object.GetCreationTimestamp().Time, finishTime)
+
+	if kubeclient.IsSucceededPod(archivePod) {
+		opStatus.Phase = common.PhaseComplete
+		opStatus.Conditions = []v1alpha1.Condition{
+			{
+				Type:               common.Complete,
+				Status:             v1.ConditionTrue,
+				Reason:             "ArchiveSuccessful",
+				Message:            "Archive Pod exec successfully and finish",
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(finishTime),
+			},
+		}
+	} else if kubeclient.IsFailedPod(archivePod) {
+		opStatus.Phase = common.PhaseFailed
+		opStatus.Conditions = []v1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             "ArchiveFailed",
+				Message:            "Archive Pod exec failed and exit",
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(finishTime),
+			},
+		}
+	}
+	return
+}
+
+func (r *DataArchiveReconciler) GetChartsDirectory() string {
+	return utils.GetChartsDirectory() + "/" + cdataarchive.DataarchiveChart
+}
+
+// UpdateStatusInfoForCompleted reports the archived bytes written by the archive pod to
+// its termination message, so no extra RBAC is needed to surface the result.
+func (r *DataArchiveReconciler) UpdateStatusInfoForCompleted(object client.Object, infos map[string]string) error {
+	_, ok := object.(*v1alpha1.DataArchive)
+	if !ok {
+		return fmt.Errorf("object %v is not a DataArchive", object)
+	}
+
+	infos[cdataarchive.ArchivedBytes] = "NA"
+
+	archivePod, err := kubeclient.GetPodByName(r.Client, utils.GetDataArchivePodName(object.GetName()), object.GetNamespace())
+	if err != nil {
+		r.Log.Error(err, "Failed to get archive pod")
+		return fmt.Errorf("failed to get archive pod")
+	}
+	for _, containerStatus := range archivePod.Status.ContainerStatuses {
+		if containerStatus.State.Terminated == nil {
+			continue
+		}
+		for _, field := range strings.Fields(containerStatus.State.Terminated.Message) {
+			if strings.HasPrefix(field, "archivedBytes=") {
+				infos[cdataarchive.ArchivedBytes] = strings.TrimPrefix(field, "archivedBytes=")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *DataArchiveReconciler) Validate(ctx runtime.ReconcileRequestContext, object client.Object) ([]v1alpha1.Condition, error) {
+	dataArchive, ok := object.(*v1alpha1.DataArchive)
+	if !ok {
+		return []v1alpha1.Condition{}, fmt.Errorf("object %v is not a DataArchive", object)
+	}
+
+	// 0. the scanned paths must be absolute
+	for _, path := range dataArchive.Spec.Paths {
+		if !strings.HasPrefix(path, "/") {
+			err := fmt.Errorf("the archive path must be absolute, path: %s", path)
+			return []v1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             "PathNotSupported",
+					Message:            "Only support absolute paths in the dataset",
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, err
+		}
+	}
+	return nil, nil
+}
+
+func (r *DataArchiveReconciler) SetTargetDatasetStatusInProgress(dataset *v1alpha1.Dataset) {
+}
+
+func (r *DataArchiveReconciler) RemoveTargetDatasetStatusInProgress(dataset *v1alpha1.Dataset) {
+}
+
+func (r *DataArchiveReconciler) GetOperationType() dataoperation.OperationType {
+	return dataoperation.DataArchive
+}
+
+func (r *DataArchiveReconciler) GetTargetDatasetNamespacedName(object client.Object) (*types.NamespacedName, error) {
+	typeObject, ok := object.(*v1alpha1.DataArchive)
+	if !ok {
+		return nil, fmt.Errorf("object %v is not a DataArchive", object)
+	}
+
+	return &types.NamespacedName{
+		Name:      typeObject.Spec.Dataset.Name,
+		Namespace: object.GetNamespace(),
+	}, nil
+}
+
+func (r *DataArchiveReconciler) GetReleaseNameSpacedName(object client.Object) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: object.GetNamespace(),
+		Name:      utils.GetDataArchiveReleaseName(object.GetName()),
+	}
+}
+
+// UpdateOperationApiStatus update the DataArchive Status
+func (r *DataArchiveReconciler) UpdateOperationApiStatus(object client.Object, opStatus *v1alpha1.OperationStatus) error {
+	dataArchive, ok := object.(*v1alpha1.DataArchive)
+	if !ok {
+		return fmt.Errorf("%+v is not a type of DataArchive", object)
+	}
+	var dataArchiveCpy = dataArchive.DeepCopy()
+	dataArchiveCpy.Status = *opStatus.DeepCopy()
+	return r.Status().Update(context.Background(), dataArchiveCpy)
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilpointer "k8s.io/utils/pointer"
+)
+
+const (
+	// ConnectivityCheckImageEnv overrides the image the connectivity self-test job runs.
+	// The image must provide the aws CLI and a POSIX shell
+	ConnectivityCheckImageEnv = "DATASET_CONNECTIVITY_CHECK_IMAGE"
+
+	defaultConnectivityCheckImage = "amazon/aws-cli:2.13.33"
+
+	// the option keys a mount may use to declare an S3-compatible endpoint, probed in order
+	mountOptionEndpoint        = "endpoint"
+	mountOptionAwsEndpoint     = "aws.endpoint"
+	mountOptionAlluxioEndpoint = "alluxio.underfs.s3.endpoint"
+)
+
+// reconcileConnectivityCheck runs an opt-in connectivity self-test against the dataset's
+// s3:// mount before the runtime is brought up. The self-test is a one-shot job that
+// issues HeadBucket and a single-page List with the mount's declared credentials and
+// endpoint, scheduled under the dataset's placement constraints so it exercises the same
+// network path the workers will. The result lands in the ConnectivityVerified dataset
+// condition, surfacing credential, endpoint or VPC issues minutes before the runtime
+// pods would hit them. The test runs once per dataset; checking reports whether it is
+// still in flight.
+func (r *DatasetReconciler) reconcileConnectivityCheck(ctx reconcileRequestContext) (checking bool, err error) {
+	log := ctx.Log.WithName("reconcileConnectivityCheck")
+
+	if ctx.Dataset.Annotations[common.AnnotationDatasetConnectivityCheck] != "true" {
+		return false, nil
+	}
+
+	// the self-test runs once, its verdict is already recorded
+	for _, cond := range ctx.Dataset.Status.Conditions {
+		if cond.Type == datav1alpha1.DatasetConnectivityVerified {
+			return false, nil
+		}
+	}
+
+	mount, found := s3MountOf(ctx.Dataset)
+	if !found {
+		return false, nil
+	}
+
+	jobName := fmt.Sprintf("%s-connectivity-check", ctx.Dataset.Name)
+	job := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: ctx.Namespace, Name: jobName}, job)
+	if err != nil {
+		if !apierrs.IsNotFound(err) {
+			return false, err
+		}
+		job = r.buildConnectivityCheckJob(ctx.Dataset, jobName, mount)
+		if err = r.Create(ctx, job); err != nil {
+			return false, err
+		}
+		log.Info("launch the connectivity self-test job", "job", jobName, "mountPoint", mount.MountPoint)
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.ConnectivityCheckStarted,
+			"Start the connectivity self-test job %s against %s", jobName, mount.MountPoint)
+		return true, nil
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != v1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return false, r.recordConnectivityResult(ctx, mount, true, "")
+		case batchv1.JobFailed:
+			return false, r.recordConnectivityResult(ctx, mount, false, cond.Message)
+		}
+	}
+
+	return true, nil
+}
+
+// recordConnectivityResult writes the self-test verdict into the ConnectivityVerified
+// dataset condition and emits the matching event.
+func (r *DatasetReconciler) recordConnectivityResult(ctx reconcileRequestContext, mount datav1alpha1.Mount, succeeded bool, detail string) (err error) {
+	var cond datav1alpha1.DatasetCondition
+	if succeeded {
+		cond = utils.NewDatasetCondition(datav1alpha1.DatasetConnectivityVerified, common.ConnectivityCheckSucceeded,
+			fmt.Sprintf("The connectivity self-test against %s succeeded", mount.MountPoint), v1.ConditionTrue)
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.ConnectivityCheckSucceeded,
+			"The connectivity self-test against %s succeeded", mount.MountPoint)
+	} else {
+		message := fmt.Sprintf("The connectivity self-test against %s failed, check the endpoint, "+
+			"credentials and network path from the cache nodes", mount.MountPoint)
+		if len(detail) > 0 {
+			message = fmt.Sprintf("%s: %s", message, detail)
+		}
+		cond = utils.NewDatasetCondition(datav1alpha1.DatasetConnectivityVerified, common.ConnectivityCheckFailed,
+			message, v1.ConditionFalse)
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.ConnectivityCheckFailed, message)
+	}
+
+	datasetToUpdate := ctx.Dataset.DeepCopy()
+	datasetToUpdate.Status.Conditions = utils.UpdateDatasetCondition(datasetToUpdate.Status.Conditions, cond)
+	return r.Status().Update(ctx, datasetToUpdate)
+}
+
+// buildConnectivityCheckJob builds the one-shot self-test job for the given s3 mount. The
+// pod inherits the dataset's node affinity and tolerations so the test runs from a node
+// the cache workers could land on.
+func (r *DatasetReconciler) buildConnectivityCheckJob(dataset datav1alpha1.Dataset, jobName string, mount datav1alpha1.Mount) *batchv1.Job {
+	bucket, prefix := splitS3MountPoint(mount.MountPoint)
+
+	endpointArgs := ""
+	if endpoint := connectivityEndpointOf(mount); len(endpoint) > 0 {
+		endpointArgs = fmt.Sprintf("--endpoint-url %s", endpoint)
+	}
+	script := fmt.Sprintf("aws s3api head-bucket --bucket %s %s && aws s3api list-objects-v2 --bucket %s --prefix '%s' --max-items 1 %s > /dev/null",
+		bucket, endpointArgs, bucket, prefix, endpointArgs)
+
+	env := []v1.EnvVar{
+		// head-bucket follows the endpoint, the region just keeps the CLI from prompting
+		{Name: "AWS_DEFAULT_REGION", Value: "us-east-1"},
+	}
+	if region, found := mount.Options["aws.region"]; found {
+		env[0].Value = region
+	}
+	for _, encryptOption := range mount.EncryptOptions {
+		envName := ""
+		switch encryptOption.Name {
+		case "aws.accessKeyId":
+			envName = "AWS_ACCESS_KEY_ID"
+		case "aws.secretKey":
+			envName = "AWS_SECRET_ACCESS_KEY"
+		default:
+			continue
+		}
+		env = append(env, v1.EnvVar{
+			Name: envName,
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{
+						Name: encryptOption.ValueFrom.SecretKeyRef.Name,
+					},
+					Key: encryptOption.ValueFrom.SecretKeyRef.Key,
+				},
+			},
+		})
+	}
+
+	var affinity *v1.Affinity
+	if dataset.Spec.NodeAffinity != nil && dataset.Spec.NodeAffinity.Required != nil {
+		affinity = &v1.Affinity{
+			NodeAffinity: &v1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: dataset.Spec.NodeAffinity.Required,
+			},
+		}
+	}
+
+	image := os.Getenv(ConnectivityCheckImageEnv)
+	if len(image) == 0 {
+		image = defaultConnectivityCheckImage
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: dataset.Namespace,
+			Labels: map[string]string{
+				common.LabelAnnotationManagedBy: dataset.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					Kind:       dataset.GetObjectKind().GroupVersionKind().Kind,
+					APIVersion: dataset.APIVersion,
+					Name:       dataset.GetName(),
+					UID:        dataset.GetUID(),
+					Controller: utilpointer.BoolPtr(true),
+				},
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          utilpointer.Int32Ptr(2),
+			ActiveDeadlineSeconds: utilpointer.Int64Ptr(300),
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					RestartPolicy: v1.RestartPolicyNever,
+					Affinity:      affinity,
+					Tolerations:   dataset.Spec.Tolerations,
+					Containers: []v1.Container{
+						{
+							Name:    "connectivity-check",
+							Image:   image,
+							Command: []string{"/bin/sh", "-c", script},
+							Env:     env,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// s3MountOf picks the dataset's first s3:// mount.
+func s3MountOf(dataset datav1alpha1.Dataset) (mount datav1alpha1.Mount, found bool) {
+	for _, m := range dataset.Spec.Mounts {
+		if strings.HasPrefix(m.MountPoint, "s3://") {
+			return m, true
+		}
+	}
+	return mount, false
+}
+
+// connectivityEndpointOf resolves the S3-compatible endpoint a mount declares through
+// its options, if any. Without one the aws CLI falls back to the AWS default endpoints.
+func connectivityEndpointOf(mount datav1alpha1.Mount) string {
+	for _, key := range []string{mountOptionEndpoint, mountOptionAwsEndpoint, mountOptionAlluxioEndpoint} {
+		if endpoint, found := mount.Options[key]; found && len(endpoint) > 0 {
+			return endpoint
+		}
+	}
+	return ""
+}
+
+// splitS3MountPoint splits an s3://bucket/path mount point into the bucket and the
+// object key prefix.
+func splitS3MountPoint(mountPoint string) (bucket, prefix string) {
+	trimmed := strings.TrimPrefix(mountPoint, "s3://")
+	parts := strings.SplitN(trimmed, "/", 2)
+	bucket = parts[0]
+	if len(parts) == 2 {
+		prefix = parts[1]
+	}
+	return
+}
@@ -16,13 +16,16 @@ package dataset
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers/deploy"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	v1 "k8s.io/api/core/v1"
@@ -53,6 +56,9 @@ type DatasetReconciler struct {
 	Log          logr.Logger
 	Scheme       *runtime.Scheme
 	ResyncPeriod time.Duration
+
+	circuitBreakerOnce sync.Once
+	circuitBreaker     *controllers.ReconcileCircuitBreaker
 }
 
 type reconcileRequestContext struct {
@@ -65,7 +71,37 @@ type reconcileRequestContext struct {
 // +kubebuilder:rbac:groups=data.fluid.io,resources=datasets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=data.fluid.io,resources=datasets/status,verbs=get;update;patch
 
-func (r *DatasetReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *DatasetReconciler) Reconcile(context context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	// quarantine datasets that keep failing or panicking so one malformed dataset can't
+	// monopolize the worker pool, see dataset_quarantine.go for the bookkeeping
+	breaker := r.breaker()
+	key := req.NamespacedName.String()
+	if open, backoff := breaker.Quarantined(key); open {
+		if !r.manualResetRequested(context, req.NamespacedName) {
+			r.Log.Info("the dataset is quarantined by the reconcile circuit breaker, skip it",
+				"dataset", key, "retryAfter", backoff)
+			return utils.RequeueAfterInterval(backoff)
+		}
+		breaker.Reset(key)
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("reconcile panicked: %v", recovered)
+			result = ctrl.Result{}
+		}
+		if err != nil {
+			if quarantined, backoff := breaker.RecordFailure(key); quarantined {
+				r.markQuarantined(context, req.NamespacedName, backoff, err)
+			}
+		} else {
+			breaker.RecordSuccess(key)
+		}
+	}()
+
+	return r.reconcileInternal(context, req)
+}
+
+func (r *DatasetReconciler) reconcileInternal(context context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx := reconcileRequestContext{
 		Context:        context,
 		Log:            r.Log.WithValues("dataset", req.NamespacedName),
@@ -155,6 +191,33 @@ func (r *DatasetReconciler) reconcileDataset(ctx reconcileRequestContext, needRe
 		}
 	}
 
+	// 3.5 Handle namespace transfer if requested by annotation
+	if _, err := r.reconcileDatasetTransfer(ctx); err != nil {
+		ctx.Log.Error(err, "Failed to transfer dataset", "ctx", ctx)
+		return utils.RequeueIfError(err)
+	}
+
+	// 3.6 Record data readiness milestones and check declared SLOs
+	if err := r.reconcileDataReadinessSLO(ctx); err != nil {
+		return utils.RequeueIfError(err)
+	}
+
+	// 3.7 Reclaim ephemeral scratch datasets whose owner workload finished
+	if reclaiming, err := r.reconcileEphemeralScratch(ctx); err != nil {
+		ctx.Log.Error(err, "Failed to reconcile the ephemeral scratch dataset", "ctx", ctx)
+		return utils.RequeueIfError(err)
+	} else if reclaiming {
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+
+	// 3.8 Run the opt-in connectivity self-test against the s3 mount before runtime bring-up
+	if checking, err := r.reconcileConnectivityCheck(ctx); err != nil {
+		ctx.Log.Error(err, "Failed to reconcile the connectivity self-test", "ctx", ctx)
+		return utils.RequeueIfError(err)
+	} else if checking {
+		return utils.RequeueAfterInterval(10 * time.Second)
+	}
+
 	// 4. Update the phase to NotBoundDatasetPhase
 	if ctx.Dataset.Status.Phase == datav1alpha1.NoneDatasetPhase {
 		dataset := ctx.Dataset.DeepCopy()
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// breaker lazily builds the reconcile circuit breaker shared by all of this
+// reconciler's workers.
+func (r *DatasetReconciler) breaker() *controllers.ReconcileCircuitBreaker {
+	r.circuitBreakerOnce.Do(func() {
+		r.circuitBreaker = controllers.NewReconcileCircuitBreaker()
+	})
+	return r.circuitBreaker
+}
+
+// manualResetRequested checks whether the user asked to close the circuit breaker for a
+// quarantined dataset by annotating it, and consumes the annotation when present.
+func (r *DatasetReconciler) manualResetRequested(ctx context.Context, key types.NamespacedName) bool {
+	dataset := &datav1alpha1.Dataset{}
+	if err := r.Get(ctx, key, dataset); err != nil {
+		// a dataset that no longer exists needs no quarantine either
+		return utils.IgnoreNotFound(err) == nil
+	}
+
+	if _, found := dataset.Annotations[common.AnnotationCircuitBreakerReset]; !found {
+		return false
+	}
+
+	datasetToUpdate := dataset.DeepCopy()
+	delete(datasetToUpdate.Annotations, common.AnnotationCircuitBreakerReset)
+	if err := r.Update(ctx, datasetToUpdate); err != nil {
+		r.Log.Error(err, "failed to consume the circuit breaker reset annotation", "dataset", key)
+		return false
+	}
+	r.Recorder.Eventf(dataset, v1.EventTypeNormal, common.ReconcileQuarantineReset,
+		"Reconcile quarantine reset manually through the %s annotation", common.AnnotationCircuitBreakerReset)
+
+	return true
+}
+
+// markQuarantined leaves a condition and an event on a dataset whose reconcile error
+// budget is exhausted, so users can tell why the controller stopped retrying eagerly.
+func (r *DatasetReconciler) markQuarantined(ctx context.Context, key types.NamespacedName, backoff time.Duration, reason error) {
+	message := fmt.Sprintf("Reconciles kept failing, quarantined for %v, last error: %v. Annotate the dataset with %s to reset",
+		backoff, reason, common.AnnotationCircuitBreakerReset)
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(r.Client, key.Name, key.Namespace)
+		if err != nil {
+			return utils.IgnoreNotFound(err)
+		}
+
+		datasetToUpdate := dataset.DeepCopy()
+		cond := utils.NewDatasetCondition(datav1alpha1.DatasetReconcileQuarantined,
+			common.ReconcileQuarantined, message, v1.ConditionTrue)
+		datasetToUpdate.Status.Conditions = utils.UpdateDatasetCondition(datasetToUpdate.Status.Conditions, cond)
+
+		if !reflect.DeepEqual(dataset.Status, datasetToUpdate.Status) {
+			if err = r.Status().Update(ctx, datasetToUpdate); err != nil {
+				return err
+			}
+		}
+		r.Recorder.Eventf(dataset, v1.EventTypeWarning, common.ReconcileQuarantined, "%s", message)
+
+		return nil
+	})
+	if err != nil {
+		r.Log.Error(err, "failed to mark the dataset quarantined", "dataset", key)
+	}
+}
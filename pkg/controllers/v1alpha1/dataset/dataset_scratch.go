@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// reconcileEphemeralScratch manages the lifecycle of ephemeral scratch datasets, i.e.
+// datasets used as managed node-local scratch space (typically a cache-only tieredstore
+// on local NVMe with no under storage). Such a dataset binds its lifecycle to a workload
+// job through an annotation: once the job finishes or disappears, the dataset is
+// reclaimed together with its runtime and scratch space. If a persist-to location is
+// declared, a final DataMigrate is issued first and the dataset is only reclaimed after
+// the migration completes.
+func (r *DatasetReconciler) reconcileEphemeralScratch(ctx reconcileRequestContext) (reclaiming bool, err error) {
+	log := ctx.Log.WithName("reconcileEphemeralScratch")
+
+	if ctx.Dataset.Annotations[common.AnnotationDatasetEphemeralScratch] != "true" {
+		return false, nil
+	}
+
+	ownerJobName, bound := ctx.Dataset.Annotations[common.AnnotationDatasetScratchOwnerJob]
+	if !bound {
+		return false, nil
+	}
+
+	finished, err := r.ownerJobFinished(ctx, ownerJobName)
+	if err != nil {
+		return false, err
+	}
+	if !finished {
+		return false, nil
+	}
+
+	// the owning workload is done, persist the scratch data first when requested
+	if persistTo, found := ctx.Dataset.Annotations[common.AnnotationDatasetScratchPersistTo]; found {
+		done, err := r.persistScratchData(ctx, persistTo)
+		if err != nil {
+			return true, err
+		}
+		if !done {
+			log.Info("wait for the final migration of the scratch data before reclaiming",
+				"dataset", ctx.Dataset.Name)
+			return true, nil
+		}
+	}
+
+	log.Info("reclaim the ephemeral scratch dataset since its owner job finished",
+		"dataset", ctx.Dataset.Name, "job", ownerJobName)
+	r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.ScratchDatasetReclaimed,
+		"Reclaim the ephemeral scratch dataset because its owner job %s finished", ownerJobName)
+	if err = r.Delete(ctx, &ctx.Dataset); err != nil && !apierrs.IsNotFound(err) {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// ownerJobFinished checks whether the job the scratch dataset is bound to has run to
+// completion, failed, or no longer exists.
+func (r *DatasetReconciler) ownerJobFinished(ctx reconcileRequestContext, jobName string) (finished bool, err error) {
+	job := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: ctx.Namespace, Name: jobName}, job)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) &&
+			cond.Status == v1.ConditionTrue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// persistScratchData ensures a final DataMigrate from the scratch dataset to the given
+// external location exists and reports whether it has completed.
+func (r *DatasetReconciler) persistScratchData(ctx reconcileRequestContext, persistTo string) (done bool, err error) {
+	migrateName := fmt.Sprintf("%s-scratch-persist", ctx.Dataset.Name)
+
+	migrate := &datav1alpha1.DataMigrate{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: ctx.Namespace, Name: migrateName}, migrate)
+	if err == nil {
+		switch migrate.Status.Phase {
+		case common.PhaseComplete:
+			return true, nil
+		case common.PhaseFailed:
+			// surface the failed persistence and retry it with a fresh DataMigrate
+			// instead of waiting on the failed one forever
+			r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DataMigrateJobFailed,
+				"Retry the failed persistence of the scratch data to %s with a new DataMigrate %s", persistTo, migrateName)
+			if err = r.Delete(ctx, migrate); err != nil && !apierrs.IsNotFound(err) {
+				return false, err
+			}
+			return false, nil
+		default:
+			return false, nil
+		}
+	}
+	if !apierrs.IsNotFound(err) {
+		return false, err
+	}
+
+	migrate = &datav1alpha1.DataMigrate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      migrateName,
+			Namespace: ctx.Namespace,
+		},
+		Spec: datav1alpha1.DataMigrateSpec{
+			From: datav1alpha1.DataToMigrate{
+				DataSet: &datav1alpha1.DatasetToMigrate{
+					Name:      ctx.Dataset.Name,
+					Namespace: ctx.Namespace,
+				},
+			},
+			To: datav1alpha1.DataToMigrate{
+				ExternalStorage: &datav1alpha1.ExternalStorage{
+					URI: persistTo,
+				},
+			},
+		},
+	}
+	if err = r.Create(ctx, migrate); err != nil {
+		if apierrs.IsAlreadyExists(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.ScratchDatasetPersisting,
+		"Persist the scratch data to %s with DataMigrate %s before reclaiming", persistTo, migrateName)
+
+	return false, nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultWarmUpPercentThreshold is the cached percentage regarded as warmed up unless
+// overridden by the common.AnnotationDatasetWarmUpSLOPercent annotation.
+const defaultWarmUpPercentThreshold = 80.0
+
+// reconcileDataReadinessSLO records the time-to-bound and time-to-warm-up milestones of
+// the dataset. The durations are measured from the dataset's creation, written to the
+// dataset status and exposed as metrics. If the dataset declares target SLOs through
+// annotations, a warning event is emitted when the observed duration exceeds the target.
+func (r *DatasetReconciler) reconcileDataReadinessSLO(ctx reconcileRequestContext) (err error) {
+	log := ctx.Log.WithName("reconcileDataReadinessSLO")
+	datasetToUpdate := ctx.Dataset.DeepCopy()
+	updated := false
+
+	if datasetToUpdate.Status.BoundedTime == nil && datasetToUpdate.Status.Phase == datav1alpha1.BoundDatasetPhase {
+		now := metav1.Now()
+		datasetToUpdate.Status.BoundedTime = &now
+		timeToBound := now.Sub(datasetToUpdate.CreationTimestamp.Time)
+		metrics.GetDatasetMetrics(ctx.Namespace, ctx.Name).SetTimeToBound(timeToBound.Seconds())
+		r.checkSLOViolation(&ctx.Dataset, common.AnnotationDatasetBoundSLO, "bound", timeToBound)
+		updated = true
+	}
+
+	if datasetToUpdate.Status.WarmedUpTime == nil && r.reachedWarmUpThreshold(ctx.Dataset) {
+		now := metav1.Now()
+		datasetToUpdate.Status.WarmedUpTime = &now
+		timeToWarmUp := now.Sub(datasetToUpdate.CreationTimestamp.Time)
+		metrics.GetDatasetMetrics(ctx.Namespace, ctx.Name).SetTimeToWarmUp(timeToWarmUp.Seconds())
+		r.checkSLOViolation(&ctx.Dataset, common.AnnotationDatasetWarmUpSLO, "warm-up", timeToWarmUp)
+		updated = true
+	}
+
+	if updated {
+		if err = r.Status().Update(ctx, datasetToUpdate); err != nil {
+			log.Error(err, "Failed to record data readiness milestones", "StatusUpdateError", ctx)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reachedWarmUpThreshold checks whether the dataset's cached percentage has reached the
+// warm-up threshold.
+func (r *DatasetReconciler) reachedWarmUpThreshold(dataset datav1alpha1.Dataset) bool {
+	cachedPercentage, found := dataset.Status.CacheStates[common.CachedPercentage]
+	if !found {
+		return false
+	}
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(cachedPercentage, "%"), 64)
+	if err != nil {
+		return false
+	}
+
+	threshold := defaultWarmUpPercentThreshold
+	if value, found := dataset.Annotations[common.AnnotationDatasetWarmUpSLOPercent]; found {
+		if parsed, err := strconv.ParseFloat(value, 64); err == nil && parsed > 0 {
+			threshold = parsed
+		}
+	}
+
+	return percent >= threshold
+}
+
+// checkSLOViolation emits a warning event if the dataset declares a target SLO through
+// the given annotation and the observed duration exceeds it.
+func (r *DatasetReconciler) checkSLOViolation(dataset *datav1alpha1.Dataset, annotation, milestone string, observed time.Duration) {
+	value, found := dataset.Annotations[annotation]
+	if !found {
+		return
+	}
+	target, err := time.ParseDuration(value)
+	if err != nil {
+		r.Recorder.Eventf(dataset, v1.EventTypeWarning, common.DatasetSLOViolated,
+			"Can't parse %s annotation value %q as a duration: %v", annotation, value, err)
+		return
+	}
+	if observed > target {
+		r.Recorder.Eventf(dataset, v1.EventTypeWarning, common.DatasetSLOViolated,
+			"Dataset took %s to get %s, exceeding the declared SLO %s", observed.Round(time.Second), milestone, target)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataset
+
+import (
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// reconcileDatasetTransfer handles the controlled ownership transfer of a dataset to
+// another namespace. A user requests the transfer by annotating the dataset with
+// common.AnnotationDatasetTransferToNamespace. The controller then recreates the
+// Dataset and its bound Runtime in the target namespace, annotated with their origin.
+// The recreated runtime reuses the same tiered store paths so that warmed cache on
+// the nodes is preserved where possible. PV/PVC are retargeted by the runtime
+// controller in the target namespace once the recreated runtime gets bound. The
+// original dataset is kept untouched and is expected to be deleted by the user after
+// workloads have switched over.
+func (r *DatasetReconciler) reconcileDatasetTransfer(ctx reconcileRequestContext) (transferred bool, err error) {
+	log := ctx.Log.WithName("reconcileDatasetTransfer")
+	targetNamespace, requested := ctx.Dataset.Annotations[common.AnnotationDatasetTransferToNamespace]
+	if !requested || targetNamespace == "" || targetNamespace == ctx.Namespace {
+		return false, nil
+	}
+
+	// Only a bound dataset with a healthy runtime can be transferred.
+	if ctx.Dataset.Status.Phase != datav1alpha1.BoundDatasetPhase {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetTransferFailed,
+			"Dataset can only be transferred when bound, current phase is %s", ctx.Dataset.Status.Phase)
+		return false, nil
+	}
+
+	// Refuse the transfer when other datasets still mount this one.
+	if len(ctx.Dataset.Status.DatasetRef) > 0 {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetTransferFailed,
+			"Dataset can't be transferred because datasets %v still mount it", ctx.Dataset.Status.DatasetRef)
+		return false, nil
+	}
+
+	// 1. Recreate the dataset in the target namespace if not done yet
+	if _, err = utils.GetDataset(r.Client, ctx.Name, targetNamespace); err != nil {
+		if utils.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+		targetDataset := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        ctx.Name,
+				Namespace:   targetNamespace,
+				Labels:      ctx.Dataset.Labels,
+				Annotations: transferredAnnotations(ctx.Dataset.Annotations, ctx.Namespace, ctx.Name),
+			},
+			Spec: *ctx.Dataset.Spec.DeepCopy(),
+		}
+		if err = r.Create(ctx, targetDataset); err != nil {
+			r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetTransferFailed,
+				"Failed to recreate dataset in namespace %s: %v", targetNamespace, err)
+			return false, err
+		}
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.DatasetTransferStarted,
+			"Recreated dataset in namespace %s", targetNamespace)
+	}
+
+	// 2. Recreate the bound runtime in the target namespace
+	if err = r.transferRuntime(ctx, targetNamespace); err != nil {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetTransferFailed,
+			"Failed to recreate runtime in namespace %s: %v", targetNamespace, err)
+		return false, err
+	}
+
+	log.Info("Transferred dataset and runtime to target namespace, the original dataset is kept for the user to delete",
+		"targetNamespace", targetNamespace)
+	r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.DatasetTransferComplete,
+		"Dataset and runtime are recreated in namespace %s, delete this dataset after workloads switch over", targetNamespace)
+	return true, nil
+}
+
+// transferRuntime recreates the dataset's bound runtime in the target namespace with
+// an identical spec. Copying the spec keeps the tiered store configuration, so workers
+// re-scheduled onto the same nodes can reuse already warmed cache directories.
+func (r *DatasetReconciler) transferRuntime(ctx reconcileRequestContext, targetNamespace string) error {
+	index, boundRuntime := utils.GetRuntimeByCategory(ctx.Dataset.Status.Runtimes, common.AccelerateCategory)
+	if index == -1 {
+		return fmt.Errorf("bound dataset %s/%s has no runtime of category %s", ctx.Namespace, ctx.Name, common.AccelerateCategory)
+	}
+
+	targetMeta := metav1.ObjectMeta{
+		Name:        boundRuntime.Name,
+		Namespace:   targetNamespace,
+		Annotations: transferredAnnotations(nil, ctx.Namespace, ctx.Name),
+	}
+
+	switch boundRuntime.Type {
+	case common.AlluxioRuntime:
+		runtime, err := utils.GetAlluxioRuntime(r.Client, boundRuntime.Name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		return r.createIfNotExists(ctx, &datav1alpha1.AlluxioRuntime{ObjectMeta: targetMeta, Spec: *runtime.Spec.DeepCopy()})
+	case common.JindoRuntime:
+		runtime, err := utils.GetJindoRuntime(r.Client, boundRuntime.Name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		return r.createIfNotExists(ctx, &datav1alpha1.JindoRuntime{ObjectMeta: targetMeta, Spec: *runtime.Spec.DeepCopy()})
+	case common.GooseFSRuntime:
+		runtime, err := utils.GetGooseFSRuntime(r.Client, boundRuntime.Name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		return r.createIfNotExists(ctx, &datav1alpha1.GooseFSRuntime{ObjectMeta: targetMeta, Spec: *runtime.Spec.DeepCopy()})
+	case common.JuiceFSRuntime:
+		runtime, err := utils.GetJuiceFSRuntime(r.Client, boundRuntime.Name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		return r.createIfNotExists(ctx, &datav1alpha1.JuiceFSRuntime{ObjectMeta: targetMeta, Spec: *runtime.Spec.DeepCopy()})
+	case common.ThinRuntime:
+		runtime, err := utils.GetThinRuntime(r.Client, boundRuntime.Name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		return r.createIfNotExists(ctx, &datav1alpha1.ThinRuntime{ObjectMeta: targetMeta, Spec: *runtime.Spec.DeepCopy()})
+	case common.EACRuntimeType:
+		runtime, err := utils.GetEACRuntime(r.Client, boundRuntime.Name, ctx.Namespace)
+		if err != nil {
+			return err
+		}
+		return r.createIfNotExists(ctx, &datav1alpha1.EACRuntime{ObjectMeta: targetMeta, Spec: *runtime.Spec.DeepCopy()})
+	default:
+		return fmt.Errorf("transferring runtime type %s is not supported", boundRuntime.Type)
+	}
+}
+
+func (r *DatasetReconciler) createIfNotExists(ctx reconcileRequestContext, obj client.Object) error {
+	err := r.Create(ctx, obj)
+	if apierrs.IsAlreadyExists(err) {
+		return nil
+	}
+	return err
+}
+
+// transferredAnnotations derives the annotations of a recreated object from the
+// original one: the transfer trigger is dropped and the origin is recorded.
+func transferredAnnotations(original map[string]string, sourceNamespace, datasetName string) map[string]string {
+	annotations := map[string]string{}
+	for key, value := range original {
+		if key == common.AnnotationDatasetTransferToNamespace {
+			continue
+		}
+		annotations[key] = value
+	}
+	annotations[common.AnnotationDatasetTransferredFrom] = fmt.Sprintf("%s/%s", sourceNamespace, datasetName)
+	return annotations
+}
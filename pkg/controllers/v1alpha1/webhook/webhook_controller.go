@@ -25,17 +25,28 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// PerNamespacePolicyEnv enables reconciling per-namespace webhook scope and failure
+// policy from namespace labels
+const PerNamespacePolicyEnv = "WEBHOOK_PER_NAMESPACE_POLICY"
+
 type WebhookReconciler struct {
-	CertBuilder *webhook.CertificateBuilder
-	WebhookName string
-	CaCert      []byte
+	CertBuilder    *webhook.CertificateBuilder
+	PolicyEnforcer *webhook.PolicyEnforcer
+	WebhookName    string
 }
 
 func (r *WebhookReconciler) Reconcile(context.Context, ctrl.Request) (ctrl.Result, error) {
-	// patch ca of MutatingWebhookConfiguration
-	err := r.CertBuilder.PatchCABundle(r.WebhookName, r.CaCert)
+	// patch ca of MutatingWebhookConfiguration, always taking the latest built CA so that
+	// a rotated certificate is never overwritten with a stale bundle
+	err := r.CertBuilder.PatchCABundle(r.WebhookName, r.CertBuilder.CACert())
 	if err != nil {
 		return utils.RequeueAfterInterval(10 * time.Second)
 	}
+
+	if r.PolicyEnforcer != nil && utils.GetBoolValueFormEnv(PerNamespacePolicyEnv, false) {
+		if err = r.PolicyEnforcer.Enforce(r.WebhookName); err != nil {
+			return utils.RequeueAfterInterval(10 * time.Second)
+		}
+	}
 	return utils.NoRequeue()
 }
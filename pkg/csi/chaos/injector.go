@@ -0,0 +1,289 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	defaultPollPeriod = 10 * time.Second
+	PollPeriodEnv     = "MOUNT_CHAOS_POLL_PERIOD"
+)
+
+var _ manager.Runnable = &Injector{}
+
+// Injector delivers deliberate mount faults on the node for resilience testing: killing
+// the fuse daemon of a dataset, dropping the connectivity to its under storage, or
+// corrupting its mount point. A fault is requested by annotating the node with
+// fluid.io/chaos-action and fluid.io/chaos-dataset; the outcome lands in
+// fluid.io/chaos-status and the action annotation is cleared, so test suites can wait on
+// it. The 'restore' action undoes the injected connectivity faults. The injector only
+// runs behind the MountChaos feature gate and must never be enabled outside staging
+// clusters.
+type Injector struct {
+	KubeClient client.Client
+
+	nodeName   string
+	pollPeriod time.Duration
+}
+
+func NewInjector(kubeClient client.Client, nodeName string) (*Injector, error) {
+	if nodeName == "" {
+		return nil, errors.New("mount chaos injection needs a node name")
+	}
+
+	pollPeriod := defaultPollPeriod
+	if os.Getenv(PollPeriodEnv) != "" {
+		var err error
+		pollPeriod, err = time.ParseDuration(os.Getenv(PollPeriodEnv))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse mount chaos poll period")
+		}
+	}
+
+	return &Injector{
+		KubeClient: kubeClient,
+		nodeName:   nodeName,
+		pollPeriod: pollPeriod,
+	}, nil
+}
+
+func (in *Injector) Start(ctx context.Context) error {
+	glog.V(3).Infof("start mount chaos injector on node %s, pollPeriod: %v", in.nodeName, in.pollPeriod)
+	wait.Until(in.pollOnce, in.pollPeriod, ctx.Done())
+	return nil
+}
+
+// pollOnce executes the chaos action the node requests, if any.
+func (in *Injector) pollOnce() {
+	node, err := kubeclient.GetNode(in.KubeClient, in.nodeName)
+	if err != nil {
+		glog.Errorf("chaos injector can't read node %s: %v", in.nodeName, err)
+		return
+	}
+
+	action := node.Annotations[common.AnnotationChaosAction]
+	if len(action) == 0 {
+		return
+	}
+	target := node.Annotations[common.AnnotationChaosDataset]
+	namespace, name, ok := splitTarget(target)
+	if !ok {
+		in.finishAction(node, fmt.Sprintf("failed: invalid target dataset %q, expect '{namespace}/{name}'", target))
+		return
+	}
+
+	glog.Infof("chaos injector executes action %s against dataset %s on node %s", action, target, in.nodeName)
+	switch action {
+	case common.ChaosActionKillFuse:
+		err = in.killFusePod(namespace, name)
+	case common.ChaosActionDropUfs:
+		err = in.dropUfsConnectivity(namespace, name)
+	case common.ChaosActionCorruptMount:
+		err = in.corruptMount(namespace, name)
+	case common.ChaosActionRestore:
+		err = in.restore(namespace, name)
+	default:
+		err = fmt.Errorf("unknown chaos action %q", action)
+	}
+
+	status := fmt.Sprintf("injected: %s %s", action, target)
+	if err != nil {
+		status = fmt.Sprintf("failed: %s %s: %v", action, target, err)
+		glog.Errorf("chaos injector failed to execute %s against %s: %v", action, target, err)
+	}
+	in.finishAction(node, status)
+}
+
+// finishAction clears the action annotation and reports the outcome, so the next
+// request starts from a clean state.
+func (in *Injector) finishAction(node *corev1.Node, status string) {
+	current := node.DeepCopy()
+	delete(node.Annotations, common.AnnotationChaosAction)
+	node.Annotations[common.AnnotationChaosStatus] = status
+	if err := in.KubeClient.Patch(context.TODO(), node, client.MergeFrom(current)); err != nil {
+		glog.Errorf("chaos injector can't update the chaos annotations of node %s: %v", in.nodeName, err)
+	}
+}
+
+// killFusePod deletes the dataset's fuse pod on this node, simulating a fuse daemon
+// crash. The daemonset brings the pod back, which is exactly the recovery path under
+// test.
+func (in *Injector) killFusePod(namespace, name string) error {
+	pods := &corev1.PodList{}
+	if err := in.KubeClient.List(context.TODO(), pods, client.InNamespace(namespace)); err != nil {
+		return err
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName != in.nodeName || !strings.HasPrefix(pod.Name, fmt.Sprintf("%s-fuse-", name)) {
+			continue
+		}
+		return in.KubeClient.Delete(context.TODO(), pod, client.GracePeriodSeconds(0))
+	}
+	return fmt.Errorf("no fuse pod of dataset %s/%s found on node %s", namespace, name, in.nodeName)
+}
+
+// dropUfsConnectivity blackholes the traffic from this node to the dataset's under
+// storage endpoints until restored. Each injected rule carries a comment naming the
+// dataset, so restore can find the rules in the kernel itself and a plugin restart
+// can't orphan them.
+func (in *Injector) dropUfsConnectivity(namespace, name string) error {
+	dataset, err := utils.GetDataset(in.KubeClient, name, namespace)
+	if err != nil {
+		return err
+	}
+
+	var hosts []string
+	for _, mount := range dataset.Spec.Mounts {
+		if common.IsFluidNativeScheme(mount.MountPoint) {
+			continue
+		}
+		parsed, err := url.Parse(mount.MountPoint)
+		if err != nil || len(parsed.Hostname()) == 0 {
+			continue
+		}
+		hosts = append(hosts, parsed.Hostname())
+	}
+	if len(hosts) == 0 {
+		return fmt.Errorf("dataset %s/%s has no external under storage endpoint to drop", namespace, name)
+	}
+
+	comment := chaosRuleComment(namespace, name)
+	for _, host := range hosts {
+		addresses, err := resolveHost(host)
+		if err != nil {
+			return err
+		}
+		for _, address := range addresses {
+			if out, err := exec.Command("iptables", "-I", "OUTPUT", "-d", address,
+				"-m", "comment", "--comment", comment, "-j", "DROP").CombinedOutput(); err != nil {
+				return fmt.Errorf("failed to drop traffic to %s: %v, output: %s", address, err, string(out))
+			}
+		}
+	}
+	return nil
+}
+
+// restore removes the connectivity faults injected for the dataset on this node. It
+// scans the ruleset for the rules tagged with the dataset's comment rather than relying
+// on any in-memory record, so faults injected before a plugin restart are restored too.
+func (in *Injector) restore(namespace, name string) error {
+	out, err := exec.Command("iptables", "-S", "OUTPUT").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to list the OUTPUT rules: %v, output: %s", err, string(out))
+	}
+
+	comment := chaosRuleComment(namespace, name)
+	var lastErr error
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "-A" || !hasRuleComment(fields, comment) {
+			continue
+		}
+		deleteArgs := append([]string{"-D"}, fields[1:]...)
+		if out, err := exec.Command("iptables", deleteArgs...).CombinedOutput(); err != nil {
+			lastErr = fmt.Errorf("failed to delete the rule %q: %v, output: %s", line, err, string(out))
+		}
+	}
+	return lastErr
+}
+
+// chaosRuleComment tags the iptables rules injected for the dataset, so restore can
+// identify them from the ruleset alone.
+func chaosRuleComment(namespace, name string) string {
+	return fmt.Sprintf("fluid-chaos-%s/%s", namespace, name)
+}
+
+// hasRuleComment checks if the tokenized iptables rule carries the given comment.
+func hasRuleComment(fields []string, comment string) bool {
+	for i := 0; i < len(fields)-1; i++ {
+		if fields[i] == "--comment" && fields[i+1] == comment {
+			return true
+		}
+	}
+	return false
+}
+
+// corruptMount lazily detaches the dataset's fuse mount point on the node, leaving the
+// bind-mounted application view with a broken transport endpoint, the same failure a
+// crashed fuse daemon leaves behind.
+func (in *Injector) corruptMount(namespace, name string) error {
+	mountRoot, err := utils.GetMountRoot()
+	if err != nil {
+		return err
+	}
+
+	// the mount point layout is <mountRoot>/<runtimeType>/<namespace>/<name>/<mountDir>
+	pattern := filepath.Join(mountRoot, "*", namespace, name, "*")
+	mountPoints, err := filepath.Glob(pattern)
+	if err != nil {
+		return err
+	}
+	if len(mountPoints) == 0 {
+		return fmt.Errorf("no mount point of dataset %s/%s found under %s", namespace, name, mountRoot)
+	}
+
+	for _, mountPoint := range mountPoints {
+		if err := syscall.Unmount(mountPoint, syscall.MNT_DETACH); err != nil && err != syscall.EINVAL {
+			return fmt.Errorf("failed to detach %s: %v", mountPoint, err)
+		}
+	}
+	return nil
+}
+
+// splitTarget parses the '{namespace}/{name}' chaos target.
+func splitTarget(target string) (namespace, name string, ok bool) {
+	parts := strings.Split(target, "/")
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// resolveHost resolves the host to its IP addresses, passing IPs through.
+func resolveHost(host string) ([]string, error) {
+	if net.ParseIP(host) != nil {
+		return []string{host}, nil
+	}
+	addresses, err := net.LookupHost(host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve under storage host %s: %v", host, err)
+	}
+	return addresses, nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/csi/config"
+	"github.com/fluid-cloudnative/fluid/pkg/csi/features"
+	utilfeature "github.com/fluid-cloudnative/fluid/pkg/utils/feature"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Register initializes the mount chaos injector and registers it to the controller
+// manager.
+func Register(mgr manager.Manager, config config.Config) error {
+	injector, err := NewInjector(mgr.GetClient(), config.NodeId)
+	if err != nil {
+		return err
+	}
+
+	return mgr.Add(injector)
+}
+
+// Enabled checks if the mount chaos injector should be enabled.
+func Enabled() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(features.MountChaos)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	defaultDiscoveryPeriod = 5 * time.Minute
+	DiscoveryPeriodEnv     = "NODE_STORAGE_DISCOVERY_PERIOD"
+	// MediaPathsEnv declares the candidate paths of each storage medium on the node,
+	// format: 'ssd=/mnt/ssd0:/mnt/ssd1,hdd=/mnt/hdd0'
+	MediaPathsEnv = "NODE_STORAGE_MEDIA_PATHS"
+)
+
+var _ manager.Runnable = &StorageDiscoverer{}
+
+// StorageDiscoverer periodically inspects the declared storage media paths on the node
+// and publishes the result as node labels and annotations, so that runtime workers with
+// matching tieredstore media can be scheduled only on nodes that actually have them.
+type StorageDiscoverer struct {
+	KubeClient client.Client
+
+	nodeName string
+	period   time.Duration
+	// mediaPaths maps a lower-cased medium name to its candidate paths on the node
+	mediaPaths map[string][]string
+}
+
+func NewStorageDiscoverer(kubeClient client.Client, nodeName string) (*StorageDiscoverer, error) {
+	if nodeName == "" {
+		return nil, errors.New("storage discovery needs a node name")
+	}
+	mediaPaths, err := parseMediaPaths(os.Getenv(MediaPathsEnv))
+	if err != nil {
+		return nil, err
+	}
+
+	period := defaultDiscoveryPeriod
+	if os.Getenv(DiscoveryPeriodEnv) != "" {
+		period, err = time.ParseDuration(os.Getenv(DiscoveryPeriodEnv))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse storage discovery period")
+		}
+	}
+
+	return &StorageDiscoverer{
+		KubeClient: kubeClient,
+		nodeName:   nodeName,
+		period:     period,
+		mediaPaths: mediaPaths,
+	}, nil
+}
+
+// parseMediaPaths parses the MediaPathsEnv value, e.g. 'ssd=/mnt/ssd0:/mnt/ssd1,hdd=/mnt/hdd0'.
+func parseMediaPaths(value string) (mediaPaths map[string][]string, err error) {
+	mediaPaths = map[string][]string{}
+	if value == "" {
+		return
+	}
+	for _, entry := range strings.Split(value, ",") {
+		fields := strings.SplitN(entry, "=", 2)
+		if len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+			return nil, fmt.Errorf("can't parse media paths entry %q, expect '{medium}={path}[:{path}...]'", entry)
+		}
+		medium := strings.ToLower(strings.TrimSpace(fields[0]))
+		mediaPaths[medium] = append(mediaPaths[medium], strings.Split(fields[1], ":")...)
+	}
+	return
+}
+
+func (s *StorageDiscoverer) Start(ctx context.Context) error {
+	glog.V(3).Infof("start node storage discovery on node %s, period: %v, media: %v", s.nodeName, s.period, s.mediaPaths)
+	wait.Until(s.discoverOnce, s.period, ctx.Done())
+	return nil
+}
+
+// discoverOnce inspects every declared medium and publishes it on the node. A medium is
+// regarded present when at least one of its paths exists; the free bytes of all present
+// paths are summed up into the capacity annotation.
+func (s *StorageDiscoverer) discoverOnce() {
+	node, err := kubeclient.GetNode(s.KubeClient, s.nodeName)
+	if err != nil {
+		glog.Errorf("storage discovery can't get node %s: %v", s.nodeName, err)
+		return
+	}
+
+	var labelsToModify common.LabelsToModify
+	annotations := map[string]string{}
+	for medium, paths := range s.mediaPaths {
+		freeBytes, present := inspectPaths(paths)
+		labelKey := common.LabelAnnotationStorageMediaPrefix + medium
+		_, labeled := node.Labels[labelKey]
+		switch {
+		case present && !labeled:
+			labelsToModify.Add(labelKey, "true")
+		case !present && labeled:
+			labelsToModify.Delete(labelKey)
+		}
+		if present {
+			annotations[labelKey+common.AnnotationStorageMediaCapacitySuffix] = strconv.FormatInt(freeBytes, 10)
+		}
+	}
+
+	if len(labelsToModify.GetLabels()) > 0 {
+		if _, err := utils.ChangeNodeLabelWithPatchMode(s.KubeClient, node, labelsToModify); err != nil {
+			glog.Errorf("storage discovery can't label node %s: %v", s.nodeName, err)
+			return
+		}
+	}
+
+	if err := s.patchCapacityAnnotations(node.Name, annotations); err != nil {
+		glog.Errorf("storage discovery can't annotate node %s: %v", s.nodeName, err)
+	}
+}
+
+// inspectPaths sums the free bytes over the paths that exist on the node.
+func inspectPaths(paths []string) (freeBytes int64, present bool) {
+	for _, path := range paths {
+		var stat syscall.Statfs_t
+		if err := syscall.Statfs(path, &stat); err != nil {
+			continue
+		}
+		present = true
+		freeBytes += int64(stat.Bavail) * stat.Bsize
+	}
+	return
+}
+
+func (s *StorageDiscoverer) patchCapacityAnnotations(nodeName string, annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+	node, err := kubeclient.GetNode(s.KubeClient, nodeName)
+	if err != nil {
+		return err
+	}
+	nodeToUpdate := node.DeepCopy()
+	if nodeToUpdate.Annotations == nil {
+		nodeToUpdate.Annotations = map[string]string{}
+	}
+	changed := false
+	for key, value := range annotations {
+		if nodeToUpdate.Annotations[key] != value {
+			nodeToUpdate.Annotations[key] = value
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return s.KubeClient.Patch(context.TODO(), nodeToUpdate, client.MergeFrom(node))
+}
@@ -25,10 +25,30 @@ import (
 const (
 	// FuseRecovery enables FUSE recovery automatically in fluid agent
 	FuseRecovery featuregate.Feature = "FuseRecovery"
+
+	// RuntimeMntJanitor enables cleaning up orphan runtime-mnt host directories
+	// whose datasets no longer exist
+	RuntimeMntJanitor featuregate.Feature = "RuntimeMntJanitor"
+
+	// NodeStorageDiscovery enables publishing the storage media available on the node
+	// as node labels for media-aware worker scheduling
+	NodeStorageDiscovery featuregate.Feature = "NodeStorageDiscovery"
+
+	// ProjectQuota enables enforcing the tieredstore disk quotas of datasets cached
+	// on the node with filesystem project quotas on XFS/ext4
+	ProjectQuota featuregate.Feature = "ProjectQuota"
+
+	// MountChaos enables the developer-facing chaos hooks that deliberately break fuse
+	// mounts on a node for resilience testing. Never enable it outside staging clusters
+	MountChaos featuregate.Feature = "MountChaos"
 )
 
 var defaultFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
-	FuseRecovery: {Default: false, PreRelease: featuregate.Beta},
+	FuseRecovery:         {Default: false, PreRelease: featuregate.Beta},
+	RuntimeMntJanitor:    {Default: false, PreRelease: featuregate.Alpha},
+	NodeStorageDiscovery: {Default: false, PreRelease: featuregate.Alpha},
+	ProjectQuota:         {Default: false, PreRelease: featuregate.Alpha},
+	MountChaos:           {Default: false, PreRelease: featuregate.Alpha},
 }
 
 func init() {
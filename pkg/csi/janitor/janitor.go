@@ -0,0 +1,188 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package janitor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	defaultScanPeriod  = 10 * time.Minute
+	defaultSafetyDelay = 30 * time.Minute
+	ScanPeriodEnv      = "RUNTIME_MNT_JANITOR_PERIOD"
+	SafetyDelayEnv     = "RUNTIME_MNT_JANITOR_DELAY"
+)
+
+var _ manager.Runnable = &RuntimeMntJanitor{}
+
+// RuntimeMntJanitor periodically scans the runtime-mnt root on the node for mount
+// directories whose datasets no longer exist and removes them after a safety delay,
+// so that host directories don't accumulate across dataset churn. The directory layout
+// it expects is <mountRoot>/<runtimeType>/<namespace>/<name>.
+type RuntimeMntJanitor struct {
+	KubeClient client.Client
+	ApiReader  client.Reader
+
+	mountRoot   string
+	scanPeriod  time.Duration
+	safetyDelay time.Duration
+
+	// firstSeenOrphan records when an orphan directory was first observed. A directory
+	// is only removed after it stays orphan for the whole safety delay.
+	firstSeenOrphan map[string]time.Time
+}
+
+func NewRuntimeMntJanitor(kubeClient client.Client, apiReader client.Reader) (*RuntimeMntJanitor, error) {
+	mountRoot, err := utils.GetMountRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "got err when getting mount root")
+	}
+
+	scanPeriod := defaultScanPeriod
+	if os.Getenv(ScanPeriodEnv) != "" {
+		scanPeriod, err = time.ParseDuration(os.Getenv(ScanPeriodEnv))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse janitor scan period")
+		}
+	}
+	safetyDelay := defaultSafetyDelay
+	if os.Getenv(SafetyDelayEnv) != "" {
+		safetyDelay, err = time.ParseDuration(os.Getenv(SafetyDelayEnv))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse janitor safety delay")
+		}
+	}
+
+	return &RuntimeMntJanitor{
+		KubeClient:      kubeClient,
+		ApiReader:       apiReader,
+		mountRoot:       mountRoot,
+		scanPeriod:      scanPeriod,
+		safetyDelay:     safetyDelay,
+		firstSeenOrphan: map[string]time.Time{},
+	}, nil
+}
+
+func (j *RuntimeMntJanitor) Start(ctx context.Context) error {
+	glog.V(3).Infof("start runtime-mnt janitor, mountRoot: %s, scanPeriod: %v, safetyDelay: %v",
+		j.mountRoot, j.scanPeriod, j.safetyDelay)
+	wait.Until(j.scanOnce, j.scanPeriod, ctx.Done())
+	return nil
+}
+
+// scanOnce walks <mountRoot>/<runtimeType>/<namespace>/<name> and handles every dataset
+// directory it finds.
+func (j *RuntimeMntJanitor) scanOnce() {
+	runtimeTypes, err := os.ReadDir(j.mountRoot)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			glog.Errorf("janitor can't read mount root %s: %v", j.mountRoot, err)
+		}
+		return
+	}
+
+	for _, runtimeType := range runtimeTypes {
+		if !runtimeType.IsDir() {
+			continue
+		}
+		namespaces, err := os.ReadDir(filepath.Join(j.mountRoot, runtimeType.Name()))
+		if err != nil {
+			continue
+		}
+		for _, namespace := range namespaces {
+			if !namespace.IsDir() {
+				continue
+			}
+			names, err := os.ReadDir(filepath.Join(j.mountRoot, runtimeType.Name(), namespace.Name()))
+			if err != nil {
+				continue
+			}
+			for _, name := range names {
+				if !name.IsDir() {
+					continue
+				}
+				j.checkDatasetDir(namespace.Name(), name.Name(),
+					filepath.Join(j.mountRoot, runtimeType.Name(), namespace.Name(), name.Name()))
+			}
+		}
+	}
+}
+
+// checkDatasetDir removes the directory if its dataset has been gone for longer than the
+// safety delay.
+func (j *RuntimeMntJanitor) checkDatasetDir(namespace, name, dir string) {
+	_, err := utils.GetDataset(j.KubeClient, name, namespace)
+	if err == nil {
+		// dataset came back, the directory is not orphan any more
+		delete(j.firstSeenOrphan, dir)
+		return
+	}
+	if !apierrs.IsNotFound(err) {
+		glog.Errorf("janitor can't check dataset %s in namespace %s: %v", name, namespace, err)
+		return
+	}
+
+	firstSeen, seen := j.firstSeenOrphan[dir]
+	if !seen {
+		glog.V(3).Infof("janitor found orphan runtime-mnt dir %s, waiting for safety delay %v", dir, j.safetyDelay)
+		j.firstSeenOrphan[dir] = time.Now()
+		return
+	}
+	if time.Since(firstSeen) < j.safetyDelay {
+		return
+	}
+
+	if err := j.cleanOrphanDir(dir); err != nil {
+		glog.Errorf("janitor failed to clean orphan runtime-mnt dir %s: %v", dir, err)
+		return
+	}
+	glog.Infof("janitor removed orphan runtime-mnt dir %s", dir)
+	delete(j.firstSeenOrphan, dir)
+}
+
+// cleanOrphanDir unmounts any leftover fuse mount points directly under the directory
+// and removes the directory afterwards.
+func (j *RuntimeMntJanitor) cleanOrphanDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		mountPoint := filepath.Join(dir, entry.Name())
+		// a dead fuse mount point answers stat with ENOTCONN, a live one is still a
+		// mount and must be detached before the directory can be removed
+		if err := syscall.Unmount(mountPoint, 0); err != nil && err != syscall.EINVAL && !os.IsNotExist(err) {
+			glog.V(3).Infof("janitor can't unmount %s: %v", mountPoint, err)
+		}
+	}
+	return os.RemoveAll(dir)
+}
@@ -18,7 +18,10 @@ package plugins
 import (
 	"crypto/sha1"
 	"encoding/hex"
+	"fmt"
 	"io"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
@@ -27,14 +30,169 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	volumehelper "github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 type controllerServer struct {
 	*csicommon.DefaultControllerServer
+	client    client.Client
+	apiReader client.Reader
+}
+
+func (cs *controllerServer) ControllerGetVolume(ctx context.Context, req *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
+	volumeID := req.GetVolumeId()
+	if len(volumeID) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Volume ID missing in request")
+	}
+
+	if err := cs.Driver.ValidateControllerServiceRequest(csi.ControllerServiceCapability_RPC_GET_VOLUME); err != nil {
+		glog.V(3).Infof("Invalid get volume req: %v", req)
+		return nil, err
+	}
+
+	pv, err := kubeclient.GetPersistentVolume(cs.apiReader, volumeID)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "volume %s not found", volumeID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get volume %s: %v", volumeID, err)
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != common.CSIDriver {
+		return nil, status.Errorf(codes.NotFound, "volume %s is not provisioned by %s", volumeID, common.CSIDriver)
+	}
+
+	return &csi.ControllerGetVolumeResponse{
+		Volume: cs.toCSIVolume(pv),
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			VolumeCondition: cs.checkVolumeCondition(pv),
+		},
+	}, nil
+}
+
+// ListVolumes enumerates all volumes provisioned by the fluid CSI driver along with their
+// health conditions, so that orchestrators and the external health monitor can discover
+// fluid volumes from the controller side.
+func (cs *controllerServer) ListVolumes(ctx context.Context, req *csi.ListVolumesRequest) (*csi.ListVolumesResponse, error) {
+	if err := cs.Driver.ValidateControllerServiceRequest(csi.ControllerServiceCapability_RPC_LIST_VOLUMES); err != nil {
+		glog.V(3).Infof("Invalid list volumes req: %v", req)
+		return nil, err
+	}
+
+	var pvs corev1.PersistentVolumeList
+	if err := cs.apiReader.List(ctx, &pvs); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list volumes: %v", err)
+	}
+
+	fluidVolumes := make([]corev1.PersistentVolume, 0, len(pvs.Items))
+	for _, pv := range pvs.Items {
+		if pv.Spec.CSI != nil && pv.Spec.CSI.Driver == common.CSIDriver {
+			fluidVolumes = append(fluidVolumes, pv)
+		}
+	}
+	// keep a stable order so that the starting token stays meaningful across calls
+	sort.Slice(fluidVolumes, func(i, j int) bool { return fluidVolumes[i].Name < fluidVolumes[j].Name })
+
+	startIdx := 0
+	if len(req.GetStartingToken()) != 0 {
+		parsed, err := strconv.Atoi(req.GetStartingToken())
+		if err != nil || parsed < 0 {
+			return nil, status.Errorf(codes.Aborted, "invalid starting token %q", req.GetStartingToken())
+		}
+		startIdx = parsed
+	}
+	if startIdx > len(fluidVolumes) {
+		startIdx = len(fluidVolumes)
+	}
+
+	endIdx := len(fluidVolumes)
+	nextToken := ""
+	if maxEntries := int(req.GetMaxEntries()); maxEntries > 0 && startIdx+maxEntries < endIdx {
+		endIdx = startIdx + maxEntries
+		nextToken = strconv.Itoa(endIdx)
+	}
+
+	entries := make([]*csi.ListVolumesResponse_Entry, 0, endIdx-startIdx)
+	for i := startIdx; i < endIdx; i++ {
+		pv := &fluidVolumes[i]
+		entries = append(entries, &csi.ListVolumesResponse_Entry{
+			Volume: cs.toCSIVolume(pv),
+			Status: &csi.ListVolumesResponse_VolumeStatus{
+				VolumeCondition: cs.checkVolumeCondition(pv),
+			},
+		})
+	}
+
+	return &csi.ListVolumesResponse{
+		Entries:   entries,
+		NextToken: nextToken,
+	}, nil
+}
+
+func (cs *controllerServer) toCSIVolume(pv *corev1.PersistentVolume) *csi.Volume {
+	return &csi.Volume{
+		VolumeId:      pv.Name,
+		CapacityBytes: pv.Spec.Capacity.Storage().Value(),
+		VolumeContext: pv.Spec.CSI.VolumeAttributes,
+	}
+}
+
+// checkVolumeCondition reports the volume healthy when its backing dataset exists and is
+// bound, complementing the node-level mount point checks.
+func (cs *controllerServer) checkVolumeCondition(pv *corev1.PersistentVolume) *csi.VolumeCondition {
+	namespace, name, err := cs.getVolumeNamespacedName(pv)
+	if err != nil {
+		return &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  fmt.Sprintf("can't determine the dataset backing the volume: %v", err),
+		}
+	}
+
+	dataset, err := utils.GetDataset(cs.client, name, namespace)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return &csi.VolumeCondition{
+				Abnormal: true,
+				Message:  fmt.Sprintf("dataset %s/%s backing the volume not found", namespace, name),
+			}
+		}
+		return &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  fmt.Sprintf("failed to check dataset %s/%s: %v", namespace, name, err),
+		}
+	}
+
+	if dataset.Status.Phase != datav1alpha1.BoundDatasetPhase {
+		return &csi.VolumeCondition{
+			Abnormal: true,
+			Message:  fmt.Sprintf("dataset %s/%s is in phase %s, expect %s", namespace, name, dataset.Status.Phase, datav1alpha1.BoundDatasetPhase),
+		}
+	}
+
+	return &csi.VolumeCondition{
+		Abnormal: false,
+		Message:  fmt.Sprintf("dataset %s/%s is bound", namespace, name),
+	}
 }
 
-func (cs *controllerServer) ControllerGetVolume(ctx context.Context, request *csi.ControllerGetVolumeRequest) (*csi.ControllerGetVolumeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "")
+func (cs *controllerServer) getVolumeNamespacedName(pv *corev1.PersistentVolume) (namespace string, name string, err error) {
+	attributes := pv.Spec.CSI.VolumeAttributes
+	if len(attributes) != 0 {
+		runtimeName, nameFound := attributes[common.VolumeAttrName]
+		runtimeNamespace, nsFound := attributes[common.VolumeAttrNamespace]
+		if nameFound && nsFound {
+			return runtimeNamespace, runtimeName, nil
+		}
+	}
+
+	return volumehelper.GetNamespacedNameByVolumeId(cs.apiReader, pv.Name)
 }
 
 func (cs *controllerServer) CreateVolume(ctx context.Context, req *csi.CreateVolumeRequest) (*csi.CreateVolumeResponse, error) {
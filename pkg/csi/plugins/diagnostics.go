@@ -0,0 +1,109 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// diagnoseFuseMountFailure explains why the runtime's FUSE mount on this node is not
+// ready by looking at the recent state of the FUSE pod: a waiting reason like
+// ImagePullBackOff or a last termination like OOMKilled tells the user far more than a
+// generic readiness timeout. Returns an empty string when no cause can be determined.
+func diagnoseFuseMountFailure(ctx context.Context, apiReader client.Reader, nodeId, namespace, name string) (cause string) {
+	fusePod, err := findFusePodOnNode(ctx, apiReader, nodeId, namespace, name)
+	if err != nil {
+		glog.V(3).Infof("fail to look up the fuse pod of %s/%s on node %s for diagnostics: %v", namespace, name, nodeId, err)
+		return ""
+	}
+	if fusePod == nil {
+		return fmt.Sprintf("no fuse pod of %s/%s is running on node %s", namespace, name, nodeId)
+	}
+	return summarizeFusePodState(fusePod)
+}
+
+// findFusePodOnNode finds the runtime's FUSE pod scheduled on the given node. FUSE pods
+// come from the "{name}-fuse" daemonset of the runtime's namespace across all engines.
+func findFusePodOnNode(ctx context.Context, apiReader client.Reader, nodeId, namespace, name string) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := apiReader.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	fusePodNamePrefix := fmt.Sprintf("%s-fuse-", name)
+	for index, pod := range pods.Items {
+		if strings.HasPrefix(pod.Name, fusePodNamePrefix) && pod.Spec.NodeName == nodeId {
+			return &pods.Items[index], nil
+		}
+	}
+	return nil, nil
+}
+
+// summarizeFusePodState condenses the FUSE pod's most telling state into one line
+func summarizeFusePodState(pod *corev1.Pod) string {
+	var reasons []string
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if waiting := containerStatus.State.Waiting; waiting != nil {
+			reason := fmt.Sprintf("container %s waiting: %s", containerStatus.Name, waiting.Reason)
+			if waiting.Message != "" {
+				reason = fmt.Sprintf("%s (%s)", reason, waiting.Message)
+			}
+			reasons = append(reasons, reason)
+		}
+		if terminated := containerStatus.LastTerminationState.Terminated; terminated != nil {
+			reason := fmt.Sprintf("container %s last terminated: %s (exit code %d)", containerStatus.Name, terminated.Reason, terminated.ExitCode)
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if len(reasons) == 0 {
+		if pod.Status.Phase != corev1.PodRunning {
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == corev1.PodScheduled && condition.Status != corev1.ConditionTrue {
+					return fmt.Sprintf("fuse pod %s is %s: %s", pod.Name, pod.Status.Phase, condition.Message)
+				}
+			}
+			return fmt.Sprintf("fuse pod %s is %s", pod.Name, pod.Status.Phase)
+		}
+		return ""
+	}
+	return fmt.Sprintf("fuse pod %s: %s", pod.Name, strings.Join(reasons, "; "))
+}
+
+// eventAppPodOnMountFailure surfaces the diagnosed cause as a warning event on the
+// application pod consuming the volume, which kubelet's pod info on mount names in the
+// volume context. Failing to emit the event never fails the publish handling itself.
+func (ns *nodeServer) eventAppPodOnMountFailure(ctx context.Context, volumeContext map[string]string, cause string) {
+	podName, podNamespace := volumeContext[podNameContextKey], volumeContext[podNamespaceContextKey]
+	if podName == "" || podNamespace == "" || ns.recorder == nil {
+		return
+	}
+
+	appPod := &corev1.Pod{}
+	if err := ns.apiReader.Get(ctx, types.NamespacedName{Namespace: podNamespace, Name: podName}, appPod); err != nil {
+		glog.V(3).Infof("fail to get application pod %s/%s for the mount failure event: %v", podNamespace, podName, err)
+		return
+	}
+	ns.recorder.Eventf(appPod, corev1.EventTypeWarning, common.FuseMountFailed, "Mount of fluid volume failed: %s", cause)
+}
@@ -21,12 +21,15 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fsnotify/fsnotify"
 	"github.com/golang/glog"
 	csicommon "github.com/kubernetes-csi/drivers/pkg/csi-common"
 )
@@ -39,13 +42,16 @@ const (
 type driver struct {
 	client           client.Client
 	apiReader        client.Reader
+	recorder         record.EventRecorder
 	csiDriver        *csicommon.CSIDriver
 	nodeId, endpoint string
+	// socketPath is the unix socket the driver serves on, empty for non-unix endpoints
+	socketPath string
 }
 
 var _ manager.Runnable = &driver{}
 
-func NewDriver(nodeID, endpoint string, client client.Client, apiReader client.Reader) *driver {
+func NewDriver(nodeID, endpoint string, client client.Client, apiReader client.Reader, recorder record.EventRecorder) *driver {
 	glog.Infof("Driver: %v version: %v", driverName, version)
 
 	proto, addr := utils.SplitSchemaAddr(endpoint)
@@ -62,22 +68,36 @@ func NewDriver(nodeID, endpoint string, client client.Client, apiReader client.R
 		os.Exit(1)
 	}
 
+	socketPath := ""
+	if proto == "unix" {
+		socketPath = addr
+	}
+
 	csiDriver := csicommon.NewCSIDriver(driverName, version, nodeID)
-	csiDriver.AddControllerServiceCapabilities([]csi.ControllerServiceCapability_RPC_Type{csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME})
+	csiDriver.AddControllerServiceCapabilities([]csi.ControllerServiceCapability_RPC_Type{
+		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
+		csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+	})
 	csiDriver.AddVolumeCapabilityAccessModes([]csi.VolumeCapability_AccessMode_Mode{csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER})
 
 	return &driver{
-		nodeId:    nodeID,
-		endpoint:  endpoint,
-		csiDriver: csiDriver,
-		client:    client,
-		apiReader: apiReader,
+		nodeId:     nodeID,
+		endpoint:   endpoint,
+		csiDriver:  csiDriver,
+		client:     client,
+		apiReader:  apiReader,
+		recorder:   recorder,
+		socketPath: socketPath,
 	}
 }
 
 func (d *driver) newControllerServer() *controllerServer {
 	return &controllerServer{
 		DefaultControllerServer: csicommon.NewDefaultControllerServer(d.csiDriver),
+		client:                  d.client,
+		apiReader:               d.apiReader,
 	}
 }
 
@@ -87,10 +107,12 @@ func (d *driver) newNodeServer() *nodeServer {
 		DefaultNodeServer: csicommon.NewDefaultNodeServer(d.csiDriver),
 		client:            d.client,
 		apiReader:         d.apiReader,
+		recorder:          d.recorder,
+		publishLocks:      newVolumeLocks(),
 	}
 }
 
-func (d *driver) run() {
+func (d *driver) run() csicommon.NonBlockingGRPCServer {
 	s := csicommon.NewNonBlockingGRPCServer()
 	s.Start(
 		d.endpoint,
@@ -98,10 +120,86 @@ func (d *driver) run() {
 		d.newControllerServer(),
 		d.newNodeServer(),
 	)
-	s.Wait()
+	return s
 }
 
 func (d *driver) Start(ctx context.Context) error {
-	d.run()
-	return nil
+	for {
+		s := d.run()
+		if len(d.socketPath) == 0 {
+			// nothing to watch for non-unix endpoints
+			s.Wait()
+			return nil
+		}
+
+		err := d.watchSocket(ctx)
+		s.Stop()
+		if err != nil || ctx.Err() != nil {
+			return err
+		}
+
+		// a kubelet restart may wipe the plugin dir together with our socket, in which
+		// case kubelet can no longer probe the driver. Recreate the socket dir and
+		// restart the grpc server so the node-driver-registrar can re-register us.
+		glog.Infof("Socket %s is gone, restarting the csi grpc server to recreate it", d.socketPath)
+		if err := os.MkdirAll(filepath.Dir(d.socketPath), 0755); err != nil {
+			return err
+		}
+	}
+}
+
+// watchSocket blocks until the served socket file disappears or the context is done. It
+// prefers inotify events on the socket dir and falls back to polling when the dir itself
+// is removed and can no longer be watched.
+func (d *driver) watchSocket(ctx context.Context) error {
+	socketDir := filepath.Dir(d.socketPath)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err = watcher.Add(socketDir); err != nil {
+		glog.Errorf("failed to watch %s: %v, falling back to polling the socket", socketDir, err)
+		return d.pollSocket(ctx)
+	}
+
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-watcher.Events:
+			if event.Name != d.socketPath && event.Name != socketDir {
+				continue
+			}
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				return nil
+			}
+		case err := <-watcher.Errors:
+			glog.Errorf("error watching %s: %v, falling back to polling the socket", socketDir, err)
+			return d.pollSocket(ctx)
+		case <-ticker.C:
+			// guard against missed events, e.g. when the dir is recreated behind the watch
+			if _, err := os.Stat(d.socketPath); os.IsNotExist(err) {
+				return nil
+			}
+		}
+	}
+}
+
+func (d *driver) pollSocket(ctx context.Context) error {
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := os.Stat(d.socketPath); os.IsNotExist(err) {
+				return nil
+			}
+		}
+	}
 }
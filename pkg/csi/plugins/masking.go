@@ -0,0 +1,197 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"k8s.io/utils/mount"
+)
+
+// maskingWorkDirRoot holds the per-volume placeholder files and directories that get
+// bind mounted over the masked paths of a published volume.
+const maskingWorkDirRoot = "/tmp/fluid/masking"
+
+// applyMaskingRules enforces the data masking rules carried in the masked paths volume
+// attribute on a freshly published target path. Each rule's path pattern is expanded
+// against the entries existing under the target path, and every match is covered by a
+// bind mount of an empty placeholder: an inaccessible one for the hide strategy, a
+// readable one for the redact strategy. Paths created in the raw dataset afterwards are
+// only covered on the next publish.
+func applyMaskingRules(ctx context.Context, volumeId string, targetPath string, encodedRules string) error {
+	var rules []v1alpha1.DataMaskingRule
+	if err := json.Unmarshal([]byte(encodedRules), &rules); err != nil {
+		return errors.Wrapf(err, "fail to decode the data masking rules of volume %s", volumeId)
+	}
+
+	workDir := filepath.Join(maskingWorkDirRoot, volumeId)
+	if err := prepareMaskingPlaceholders(workDir); err != nil {
+		return errors.Wrapf(err, "fail to prepare the masking placeholders under %s", workDir)
+	}
+
+	for _, rule := range rules {
+		pattern := rule.PathPattern
+		if pattern == "" || strings.HasPrefix(pattern, "/") || strings.Contains(pattern, "..") {
+			return errors.Errorf("invalid data masking path pattern %q, expect a relative pattern without \"..\"", pattern)
+		}
+
+		matches, err := filepath.Glob(filepath.Join(targetPath, pattern))
+		if err != nil {
+			return errors.Wrapf(err, "fail to expand the data masking path pattern %q", pattern)
+		}
+		if len(matches) == 0 {
+			glog.V(3).Infof("Data masking pattern %q matches no path under %s", pattern, targetPath)
+			continue
+		}
+
+		for _, match := range matches {
+			if err := maskPath(ctx, workDir, match, rule.Strategy); err != nil {
+				return errors.Wrapf(err, "fail to mask path %s with strategy %q", match, rule.Strategy)
+			}
+		}
+		glog.Infof("Masked %d path(s) matching %q under %s", len(matches), pattern, targetPath)
+	}
+
+	return nil
+}
+
+// maskPath covers a single matched path with the placeholder fitting its type and strategy
+func maskPath(ctx context.Context, workDir string, path string, strategy v1alpha1.DataMaskingStrategy) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+
+	var placeholder string
+	switch {
+	case info.IsDir() && strategy == v1alpha1.RedactMaskingStrategy:
+		placeholder = filepath.Join(workDir, "redact-dir")
+	case info.IsDir():
+		placeholder = filepath.Join(workDir, "hide-dir")
+	case strategy == v1alpha1.RedactMaskingStrategy:
+		placeholder = filepath.Join(workDir, "redact-file")
+	default:
+		placeholder = filepath.Join(workDir, "hide-file")
+	}
+
+	command := exec.CommandContext(ctx, "mount", "--bind", placeholder, path)
+	glog.V(4).Infoln(command)
+	stdoutStderr, err := command.CombinedOutput()
+	glog.V(4).Infoln(string(stdoutStderr))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return err
+	}
+	return nil
+}
+
+// prepareMaskingPlaceholders creates the empty placeholder sources shared by all masked
+// paths of a volume: hide placeholders deny any access, redact placeholders read as empty
+func prepareMaskingPlaceholders(workDir string) error {
+	if err := os.MkdirAll(workDir, 0750); err != nil {
+		return err
+	}
+	placeholders := []struct {
+		name string
+		dir  bool
+		mode os.FileMode
+	}{
+		{name: "hide-dir", dir: true, mode: 0000},
+		{name: "redact-dir", dir: true, mode: 0555},
+		{name: "hide-file", mode: 0000},
+		{name: "redact-file", mode: 0444},
+	}
+	for _, p := range placeholders {
+		path := filepath.Join(workDir, p.name)
+		if p.dir {
+			if err := os.MkdirAll(path, 0750); err != nil {
+				return err
+			}
+		} else {
+			file, err := os.OpenFile(path, os.O_CREATE, 0640)
+			if err != nil {
+				return err
+			}
+			if err = file.Close(); err != nil {
+				return err
+			}
+		}
+		if err := os.Chmod(path, p.mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmountNestedMounts unwinds the placeholder bind mounts stacked under a target path,
+// deepest first, so that the target path itself can be unmounted afterwards
+func unmountNestedMounts(targetPath string) error {
+	nested, err := listNestedMounts(targetPath)
+	if err != nil {
+		return err
+	}
+
+	mounter := mount.New("")
+	for _, mountPoint := range nested {
+		glog.V(3).Infof("umount nested mount point %s", mountPoint)
+		if err := mounter.Unmount(mountPoint); err != nil {
+			return errors.Wrapf(err, "fail to umount nested mount point %s", mountPoint)
+		}
+	}
+	return nil
+}
+
+// listNestedMounts returns the mount points strictly under the given path, deepest first
+func listNestedMounts(targetPath string) (nested []string, err error) {
+	file, err := os.ReadFile("/proc/mounts")
+	if err != nil {
+		return nested, err
+	}
+	prefix := targetPath + "/"
+	for _, line := range strings.Split(string(file), "\n") {
+		tokens := strings.Split(line, " ")
+		if len(tokens) < 2 {
+			continue
+		}
+		if strings.HasPrefix(tokens[1], prefix) {
+			nested = append(nested, tokens[1])
+		}
+	}
+	sort.Slice(nested, func(i, j int) bool {
+		return strings.Count(nested[i], "/") > strings.Count(nested[j], "/")
+	})
+	return nested, nil
+}
+
+// cleanUpMaskingWorkDir removes the per-volume placeholder directory once the volume is
+// fully unpublished, ignoring errors since stale placeholders are harmless
+func cleanUpMaskingWorkDir(volumeId string) {
+	workDir := filepath.Join(maskingWorkDirRoot, volumeId)
+	if err := os.RemoveAll(workDir); err != nil {
+		glog.Warningf("Ignoring error when cleaning up masking work dir %s: %v", workDir, err)
+	}
+}
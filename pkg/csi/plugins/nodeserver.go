@@ -33,6 +33,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/mount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,15 +47,34 @@ import (
 
 const (
 	AllowPatchStaleNodeEnv = "ALLOW_PATCH_STALE_NODE"
+
+	// DefaultRPCTimeoutEnv overrides the fallback per-RPC deadline that is applied
+	// when the incoming gRPC context carries none.
+	DefaultRPCTimeoutEnv = "CSI_DEFAULT_RPC_TIMEOUT"
+
+	defaultRPCTimeout = 2 * time.Minute
 )
 
+// ensureRPCDeadline derives a request-scoped context that always carries a deadline, so
+// that in-flight work (exec commands, readiness waits) is cancelled when kubelet times
+// the RPC out instead of leaking goroutines and processes, even for callers that attach
+// no deadline at all.
+func ensureRPCDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, hasDeadline := ctx.Deadline(); hasDeadline {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, utils.GetDurationValueFromEnv(DefaultRPCTimeoutEnv, defaultRPCTimeout))
+}
+
 type nodeServer struct {
 	nodeId string
 	*csicommon.DefaultNodeServer
-	client    client.Client
-	apiReader client.Reader
-	mutex     sync.Mutex
-	node      *v1.Node
+	client       client.Client
+	apiReader    client.Reader
+	recorder     record.EventRecorder
+	mutex        sync.Mutex
+	node         *v1.Node
+	publishLocks *volumeLocks
 }
 
 func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
@@ -62,6 +82,18 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 	glog.Infof("NodePublishVolumeRequest is %v", req)
 	targetPath := req.GetTargetPath()
 
+	ctx, cancel := ensureRPCDeadline(ctx)
+	defer cancel()
+
+	// Kubelet may retry a publish rapidly. Serialize requests with the same
+	// (volumeId, targetPath) so that concurrent identical requests coalesce to a
+	// single mount attempt instead of racing on mkdir and duplicating bind mounts.
+	lockKey := fmt.Sprintf("%s-%s", req.GetVolumeId(), targetPath)
+	if duplicated := ns.publishLocks.Lock(lockKey); duplicated {
+		glog.Infof("NodePublishVolume: suppressed duplicate publish for volume %s on %s, waited for the in-flight one to finish", req.GetVolumeId(), targetPath)
+	}
+	defer ns.publishLocks.Unlock(lockKey)
+
 	isMount, err := utils.IsMounted(targetPath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -124,8 +156,19 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 	}
 
 	// 1. Wait the runtime fuse ready and check the sub path existence
-	err = utils.CheckMountReadyAndSubPathExist(fluidPath, mountType, subPath)
+	err = utils.CheckMountReadyAndSubPathExist(ctx, fluidPath, mountType, subPath)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
+		// explain the readiness failure from the FUSE pod's recent state, so users see
+		// e.g. "OOMKilled" on their pod instead of only a generic timeout
+		if namespace, name, nsErr := ns.getRuntimeNamespacedName(req.GetVolumeContext(), req.GetVolumeId()); nsErr == nil {
+			if cause := diagnoseFuseMountFailure(ctx, ns.apiReader, ns.nodeId, namespace, name); cause != "" {
+				ns.eventAppPodOnMountFailure(ctx, req.GetVolumeContext(), cause)
+				return nil, status.Errorf(codes.Internal, "%v (%s)", err, cause)
+			}
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -139,12 +182,15 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 	} else {
 		args = append(args, mountPath, targetPath)
 	}
-	command := exec.Command("mount", args...)
+	command := exec.CommandContext(ctx, "mount", args...)
 
 	glog.V(4).Infoln(command)
 	stdoutStderr, err := command.CombinedOutput()
 	glog.V(4).Infoln(string(stdoutStderr))
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
 		if os.IsPermission(err) {
 			return nil, status.Error(codes.PermissionDenied, err.Error())
 		}
@@ -156,16 +202,72 @@ func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublis
 		glog.V(4).Infof("Succeed in binding %s to %s", mountPath, targetPath)
 	}
 
+	// 2. Idmap the mount when the consuming pod runs with user namespaces, so that file
+	// ownership translates into the pod's ID mapping instead of collapsing to nobody
+	if userns, mappings, usernsErr := podUsernsMapping(ctx, ns.apiReader, req.GetVolumeContext()); usernsErr != nil {
+		glog.Warningf("NodePublishVolume: fail to check the user namespace of the pod consuming volume %s, publishing without idmapping: %v", req.GetVolumeId(), usernsErr)
+	} else if userns {
+		switch {
+		case !supportsIdmappedMounts():
+			glog.Warningf("NodePublishVolume: the pod consuming volume %s runs with user namespaces but the node offers no idmapped mounts, file ownership will not be translated", req.GetVolumeId())
+		case len(mappings) == 0:
+			glog.Warningf("NodePublishVolume: the pod consuming volume %s runs with user namespaces but carries no %s annotation, file ownership will not be translated", req.GetVolumeId(), common.AnnotationUsernsMapping)
+		default:
+			if err := applyIdmappedMount(ctx, targetPath, mappings); err != nil {
+				glog.Errorf("NodePublishVolume: fail to idmap the mount at %s: %v", targetPath, err)
+				if umountErr := mount.New("").Unmount(targetPath); umountErr != nil {
+					glog.Errorf("NodePublishVolume: fail to umount %s after idmapping failure: %v", targetPath, umountErr)
+				}
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, status.FromContextError(ctxErr).Err()
+				}
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			glog.Infof("Succeed in idmapping the mount at %s for the pod's user namespace", targetPath)
+		}
+	}
+
+	// 3. Mask sensitive paths when the volume carries data masking rules, rolling the
+	// bind mount back on failure so an unmasked view is never handed to the pod
+	if encodedRules := req.GetVolumeContext()[common.VolumeAttrFluidMaskedPaths]; encodedRules != "" {
+		if err := applyMaskingRules(ctx, req.GetVolumeId(), targetPath, encodedRules); err != nil {
+			glog.Errorf("NodePublishVolume: fail to apply data masking rules on %s: %v", targetPath, err)
+			if unwindErr := unmountNestedMounts(targetPath); unwindErr != nil {
+				glog.Errorf("NodePublishVolume: fail to unwind masking mounts under %s: %v", targetPath, unwindErr)
+			}
+			if umountErr := mount.New("").Unmount(targetPath); umountErr != nil {
+				glog.Errorf("NodePublishVolume: fail to umount %s after masking failure: %v", targetPath, umountErr)
+			}
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, status.FromContextError(ctxErr).Err()
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return &csi.NodePublishVolumeResponse{}, nil
 }
 
 func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpublishVolumeRequest) (*csi.NodeUnpublishVolumeResponse, error) {
 	targetPath := req.GetTargetPath()
 
+	ctx, cancel := ensureRPCDeadline(ctx)
+	defer cancel()
+
+	// masking placeholder mounts are stacked under the target path and would keep the
+	// target path busy, so unwind them first
+	if err := unmountNestedMounts(targetPath); err != nil {
+		return nil, errors.Wrapf(err, "NodeUnpublishVolume: fail to unwind nested mounts under %s", targetPath)
+	}
+
 	// targetPath may be mount bind many times when mount point recovered.
 	// umount until it's not mounted.
 	mounter := mount.New("")
 	for {
+		// stop unwinding the bind mounts when kubelet has already given up on the RPC
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, status.FromContextError(ctxErr).Err()
+		}
 		notMount, err := mounter.IsLikelyNotMountPoint(targetPath)
 		if err != nil {
 			glog.V(3).Infoln(err)
@@ -193,10 +295,15 @@ func (ns *nodeServer) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpu
 		glog.V(4).Infof("Succeed in umounting  %s", targetPath)
 	}
 
+	cleanUpMaskingWorkDir(req.GetVolumeId())
+
 	return &csi.NodeUnpublishVolumeResponse{}, nil
 }
 
 func (ns *nodeServer) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstageVolumeRequest) (*csi.NodeUnstageVolumeResponse, error) {
+	ctx, cancel := ensureRPCDeadline(ctx)
+	defer cancel()
+
 	// The lock is to ensure CSI plugin labels the node in correct order
 	ns.mutex.Lock()
 	defer ns.mutex.Unlock()
@@ -244,7 +351,7 @@ func (ns *nodeServer) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstag
 	}
 
 	// 3. check if the path is mounted
-	inUse, err := checkMountInUse(req.GetVolumeId())
+	inUse, err := checkMountInUse(ctx, req.GetVolumeId())
 	if err != nil {
 		return nil, errors.Wrap(err, "NodeUnstageVolume: can't check mount in use")
 	}
@@ -276,6 +383,9 @@ func (ns *nodeServer) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstag
 }
 
 func (ns *nodeServer) NodeStageVolume(ctx context.Context, req *csi.NodeStageVolumeRequest) (*csi.NodeStageVolumeResponse, error) {
+	ctx, cancel := ensureRPCDeadline(ctx)
+	defer cancel()
+
 	// The lock is to ensure CSI plugin labels the node in correct order
 	ns.mutex.Lock()
 	defer ns.mutex.Unlock()
@@ -284,7 +394,7 @@ func (ns *nodeServer) NodeStageVolume(ctx context.Context, req *csi.NodeStageVol
 	// 1. Start SessMgr Pod and wait for ready if FUSE pod requires SessMgr
 	sessMgrWorkDir := req.GetVolumeContext()[common.VolumeAttrEACSessMgrWorkDir]
 	if len(sessMgrWorkDir) != 0 {
-		if err := ns.prepareSessMgr(sessMgrWorkDir); err != nil {
+		if err := ns.prepareSessMgr(ctx, sessMgrWorkDir); err != nil {
 			glog.Errorf("NodeStageVolume: fail to prepare SessMgr because: %v", err)
 			return nil, errors.Wrapf(err, "NodeStageVolume: fail to prepare SessMgr")
 		}
@@ -308,6 +418,12 @@ func (ns *nodeServer) NodeStageVolume(ctx context.Context, req *csi.NodeStageVol
 	var labelsToModify common.LabelsToModify
 	labelsToModify.Add(fuseLabelKey, "true")
 
+	// advertise idmapped mount support, so schedulers and webhooks can tell whether
+	// Fluid volumes on this node work for pods running with user namespaces
+	if supportsIdmappedMounts() {
+		labelsToModify.Add(common.LabelNodeIdmappedMounts, "true")
+	}
+
 	node, err := ns.getNode()
 	if err != nil {
 		glog.Errorf("NodeStageVolume: can't get node %s: %v", ns.nodeId, err)
@@ -380,7 +496,7 @@ func (ns *nodeServer) getNode() (node *v1.Node, err error) {
 	return ns.node, nil
 }
 
-func checkMountInUse(volumeName string) (bool, error) {
+func checkMountInUse(ctx context.Context, volumeName string) (bool, error) {
 	var inUse bool
 	glog.Infof("Try to check if the volume %s is being used", volumeName)
 	if volumeName == "" {
@@ -389,12 +505,15 @@ func checkMountInUse(volumeName string) (bool, error) {
 
 	// TODO: refer to https://github.com/kubernetes-sigs/alibaba-cloud-csi-driver/blob/4fcb743220371de82d556ab0b67b08440b04a218/pkg/oss/utils.go#L72
 	// for a better implementation
-	command := exec.Command("/usr/local/bin/check_bind_mounts.sh", volumeName)
+	command := exec.CommandContext(ctx, "/usr/local/bin/check_bind_mounts.sh", volumeName)
 	glog.Infoln(command)
 
 	stdoutStderr, err := command.CombinedOutput()
 	glog.Infoln(string(stdoutStderr))
 
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return inUse, ctxErr
+	}
 	if err != nil {
 		if exiterr, ok := err.(*exec.ExitError); ok {
 			if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
@@ -443,7 +562,7 @@ func cleanUpBrokenMountPoint(mountPoint string) error {
 	return nil
 }
 
-func (ns *nodeServer) prepareSessMgr(workDir string) error {
+func (ns *nodeServer) prepareSessMgr(ctx context.Context, workDir string) error {
 	sessMgrLabelKey := common.SessMgrNodeSelectorKey
 	var labelsToModify common.LabelsToModify
 	labelsToModify.Add(sessMgrLabelKey, "true")
@@ -472,7 +591,11 @@ func (ns *nodeServer) prepareSessMgr(workDir string) error {
 		if !os.IsNotExist(err) {
 			glog.Errorf("fail to os.Stat sessmgr socket file %s", sessMgrSockFilePath)
 		}
-		time.Sleep(1 * time.Second)
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "cancelled while waiting for SessMgr Pod to be ready")
+		case <-time.After(1 * time.Second):
+		}
 	}
 
 	if i >= retryLimit {
@@ -23,7 +23,7 @@ import (
 
 // Register initializes the csi driver and registers it to the controller manager.
 func Register(mgr manager.Manager, cfg config.Config) error {
-	csiDriver := NewDriver(cfg.NodeId, cfg.Endpoint, mgr.GetClient(), mgr.GetAPIReader())
+	csiDriver := NewDriver(cfg.NodeId, cfg.Endpoint, mgr.GetClient(), mgr.GetAPIReader(), mgr.GetEventRecorderFor("FuseCSI"))
 
 	if err := mgr.Add(csiDriver); err != nil {
 		return err
@@ -0,0 +1,217 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EnableIdmappedMountsEnv force-enables ("true") or disables ("false") idmapped
+	// bind mounts for pods running with user namespaces. When unset, kernel support
+	// is probed automatically.
+	EnableIdmappedMountsEnv = "CSI_ENABLE_IDMAPPED_MOUNTS"
+
+	// volume context keys kubelet fills in because the driver sets podInfoOnMount
+	podNameContextKey      = "csi.storage.k8s.io/pod.name"
+	podNamespaceContextKey = "csi.storage.k8s.io/pod.namespace"
+)
+
+var (
+	idmappedMountsOnce      sync.Once
+	idmappedMountsSupported bool
+)
+
+// supportsIdmappedMounts reports whether idmapped bind mounts can be used on this node.
+// The kernel support (mount_setattr(2), kernel >= 5.12) is probed once by issuing the
+// syscall with arguments that are invalid on every kernel offering it, so that ENOSYS
+// cleanly separates missing support from the expected failure. The probe can be
+// overridden either way with the CSI_ENABLE_IDMAPPED_MOUNTS env variable.
+func supportsIdmappedMounts() bool {
+	if envVar, found := os.LookupEnv(EnableIdmappedMountsEnv); found {
+		return envVar == "true"
+	}
+
+	idmappedMountsOnce.Do(func() {
+		err := unix.MountSetattr(-1, "", 0, &unix.MountAttr{})
+		idmappedMountsSupported = err != nil && !errors.Is(err, unix.ENOSYS)
+		if !idmappedMountsSupported {
+			glog.V(3).Infof("The kernel offers no mount_setattr(2), idmapped mounts are disabled: %v", err)
+		}
+	})
+	return idmappedMountsSupported
+}
+
+// idMapping is one '{containerID}:{hostID}:{length}' range of a pod's user namespace
+// ID mapping, applied to both UIDs and GIDs.
+type idMapping struct {
+	containerID int
+	hostID      int
+	length      int
+}
+
+// podUsernsMapping checks whether the pod consuming the volume runs with user
+// namespaces (spec.hostUsers: false) and returns its declared ID mapping. The pod is
+// read as unstructured because the hostUsers field postdates the vendored pod API.
+// The mapping itself comes from the pod's userns mapping annotation: kubelet picks
+// the mapping together with the container runtime but does not pass it through CSI,
+// so clusters have to mirror it onto the pod for Fluid to idmap the mount.
+func podUsernsMapping(ctx context.Context, apiReader client.Reader, volumeContext map[string]string) (userns bool, mappings []idMapping, err error) {
+	podName, podNamespace := volumeContext[podNameContextKey], volumeContext[podNamespaceContextKey]
+	if podName == "" || podNamespace == "" {
+		return false, nil, nil
+	}
+
+	pod := &unstructured.Unstructured{}
+	pod.SetAPIVersion("v1")
+	pod.SetKind("Pod")
+	if err := apiReader.Get(ctx, types.NamespacedName{Namespace: podNamespace, Name: podName}, pod); err != nil {
+		return false, nil, errors.Wrapf(err, "fail to get pod %s/%s consuming the volume", podNamespace, podName)
+	}
+
+	hostUsers, found, err := unstructured.NestedBool(pod.Object, "spec", "hostUsers")
+	if err != nil {
+		return false, nil, errors.Wrapf(err, "fail to read spec.hostUsers of pod %s/%s", podNamespace, podName)
+	}
+	if !found || hostUsers {
+		return false, nil, nil
+	}
+
+	encodedMapping := pod.GetAnnotations()[common.AnnotationUsernsMapping]
+	if encodedMapping == "" {
+		return true, nil, nil
+	}
+	mappings, err = parseUsernsMapping(encodedMapping)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "fail to parse annotation %s of pod %s/%s", common.AnnotationUsernsMapping, podNamespace, podName)
+	}
+	return true, mappings, nil
+}
+
+// parseUsernsMapping parses a '{containerID}:{hostID}:{length}[,...]' mapping
+func parseUsernsMapping(encodedMapping string) (mappings []idMapping, err error) {
+	for _, rangeStr := range strings.Split(encodedMapping, ",") {
+		parts := strings.Split(strings.TrimSpace(rangeStr), ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid ID mapping range %q, expect '{containerID}:{hostID}:{length}'", rangeStr)
+		}
+		var ids [3]int
+		for i, part := range parts {
+			if ids[i], err = strconv.Atoi(part); err != nil || ids[i] < 0 {
+				return nil, fmt.Errorf("invalid ID %q in mapping range %q", part, rangeStr)
+			}
+		}
+		if ids[2] <= 0 {
+			return nil, fmt.Errorf("invalid length %d in mapping range %q", ids[2], rangeStr)
+		}
+		mappings = append(mappings, idMapping{containerID: ids[0], hostID: ids[1], length: ids[2]})
+	}
+	return mappings, nil
+}
+
+// applyIdmappedMount stacks an idmapped clone of the published bind mount over the
+// target path, so that file ownership inside the pod's user namespace translates to
+// the raw IDs of the dataset instead of collapsing to nobody. The user namespace
+// holding the mapping is created by a short-lived helper process, its ns file is then
+// attached to an open_tree(2) clone of the mount via mount_setattr(2).
+func applyIdmappedMount(ctx context.Context, targetPath string, mappings []idMapping) error {
+	helper, usernsFile, err := startUsernsHelper(ctx, mappings)
+	if err != nil {
+		return errors.Wrap(err, "fail to create the user namespace holding the ID mapping")
+	}
+	defer func() {
+		if closeErr := usernsFile.Close(); closeErr != nil {
+			glog.Warningf("Ignoring error when closing the user namespace file: %v", closeErr)
+		}
+		if killErr := helper.Process.Kill(); killErr != nil {
+			glog.Warningf("Ignoring error when stopping the user namespace helper: %v", killErr)
+		}
+		_ = helper.Wait()
+	}()
+
+	treeFd, err := unix.OpenTree(unix.AT_FDCWD, targetPath, unix.OPEN_TREE_CLONE|unix.O_CLOEXEC)
+	if err != nil {
+		return errors.Wrapf(err, "fail to clone the mount at %s with open_tree", targetPath)
+	}
+	defer func() {
+		if closeErr := unix.Close(treeFd); closeErr != nil {
+			glog.Warningf("Ignoring error when closing the cloned mount fd: %v", closeErr)
+		}
+	}()
+
+	attr := &unix.MountAttr{
+		Attr_set:  unix.MOUNT_ATTR_IDMAP,
+		Userns_fd: uint64(usernsFile.Fd()),
+	}
+	if err := unix.MountSetattr(treeFd, "", unix.AT_EMPTY_PATH, attr); err != nil {
+		return errors.Wrapf(err, "fail to idmap the cloned mount of %s", targetPath)
+	}
+
+	if err := unix.MoveMount(treeFd, "", unix.AT_FDCWD, targetPath, unix.MOVE_MOUNT_F_EMPTY_PATH); err != nil {
+		return errors.Wrapf(err, "fail to attach the idmapped mount over %s", targetPath)
+	}
+	return nil
+}
+
+// startUsernsHelper starts a sleeping helper process in a fresh user namespace set up
+// with the given ID mapping and opens its /proc/{pid}/ns/user file. The helper only
+// exists to keep the namespace alive until the mount is idmapped.
+func startUsernsHelper(ctx context.Context, mappings []idMapping) (helper *exec.Cmd, usernsFile *os.File, err error) {
+	idMaps := make([]syscall.SysProcIDMap, 0, len(mappings))
+	for _, mapping := range mappings {
+		idMaps = append(idMaps, syscall.SysProcIDMap{
+			ContainerID: mapping.containerID,
+			HostID:      mapping.hostID,
+			Size:        mapping.length,
+		})
+	}
+
+	helper = exec.CommandContext(ctx, "sleep", "60")
+	helper.SysProcAttr = &syscall.SysProcAttr{
+		Cloneflags:                 syscall.CLONE_NEWUSER,
+		UidMappings:                idMaps,
+		GidMappings:                idMaps,
+		GidMappingsEnableSetgroups: false,
+	}
+	if err = helper.Start(); err != nil {
+		return nil, nil, err
+	}
+
+	usernsFile, err = os.Open(fmt.Sprintf("/proc/%d/ns/user", helper.Process.Pid))
+	if err != nil {
+		if killErr := helper.Process.Kill(); killErr != nil {
+			glog.Warningf("Ignoring error when stopping the user namespace helper: %v", killErr)
+		}
+		_ = helper.Wait()
+		return nil, nil, err
+	}
+	return helper, usernsFile, nil
+}
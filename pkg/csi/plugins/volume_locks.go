@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"sync"
+)
+
+// volumeLocks serializes in-flight node operations on a per-key basis. Keys are
+// expected to uniquely identify an operation target (e.g. "<volumeId>-<targetPath>"),
+// so that identical requests retried by kubelet coalesce to a single attempt while
+// requests on different volumes or target paths still run in parallel.
+type volumeLocks struct {
+	mutex sync.Mutex
+	locks map[string]*volumeLockEntry
+}
+
+type volumeLockEntry struct {
+	// sem is a binary semaphore guarding the critical section for one key.
+	sem chan struct{}
+	// refs counts the holders and waiters of the entry so that it can be
+	// garbage collected once the last one releases it.
+	refs int
+}
+
+func newVolumeLocks() *volumeLocks {
+	return &volumeLocks{
+		locks: map[string]*volumeLockEntry{},
+	}
+}
+
+// Lock blocks until the lock for the given key is acquired. It returns true if
+// an identical operation was already in flight when Lock was called, meaning
+// the caller has waited for that operation's result.
+func (l *volumeLocks) Lock(key string) (duplicated bool) {
+	l.mutex.Lock()
+	entry, found := l.locks[key]
+	if !found {
+		entry = &volumeLockEntry{sem: make(chan struct{}, 1)}
+		l.locks[key] = entry
+	}
+	entry.refs++
+	l.mutex.Unlock()
+
+	entry.sem <- struct{}{}
+	return found
+}
+
+// Unlock releases the lock for the given key acquired by a former call to Lock.
+func (l *volumeLocks) Unlock(key string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	entry, found := l.locks[key]
+	if !found {
+		return
+	}
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.locks, key)
+	}
+	<-entry.sem
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package projectquota
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	defaultSyncPeriod = 5 * time.Minute
+	SyncPeriodEnv     = "PROJECT_QUOTA_SYNC_PERIOD"
+
+	// projectIDBase keeps the fluid-managed project IDs clear of the low range other
+	// tools typically hand out
+	projectIDBase = 1000000
+)
+
+var _ manager.Runnable = &QuotaEnforcer{}
+
+// QuotaEnforcer enforces the tieredstore disk quotas of the datasets cached on this node
+// with filesystem project quotas, so a dataset exceeding its declared quota cannot fill
+// the shared disk used by other datasets. The controller coordinates the placement
+// through the cache node labels; the enforcer follows them and pins each per-dataset
+// tier directory ({path}/{namespace}/{name}) to a project with the declared quota as its
+// hard limit. This replaces trusting the engine-level watermark properties with kernel
+// enforcement on XFS and ext4 (mounted with 'prjquota').
+type QuotaEnforcer struct {
+	KubeClient client.Client
+
+	nodeName   string
+	syncPeriod time.Duration
+}
+
+func NewQuotaEnforcer(kubeClient client.Client, nodeName string) (*QuotaEnforcer, error) {
+	if nodeName == "" {
+		return nil, errors.New("project quota enforcement needs a node name")
+	}
+
+	syncPeriod := defaultSyncPeriod
+	if os.Getenv(SyncPeriodEnv) != "" {
+		var err error
+		syncPeriod, err = time.ParseDuration(os.Getenv(SyncPeriodEnv))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse project quota sync period")
+		}
+	}
+
+	return &QuotaEnforcer{
+		KubeClient: kubeClient,
+		nodeName:   nodeName,
+		syncPeriod: syncPeriod,
+	}, nil
+}
+
+func (e *QuotaEnforcer) Start(ctx context.Context) error {
+	glog.V(3).Infof("start project quota enforcer on node %s, syncPeriod: %v", e.nodeName, e.syncPeriod)
+	wait.Until(e.syncOnce, e.syncPeriod, ctx.Done())
+	return nil
+}
+
+// syncOnce walks the runtimes whose cache is placed on this node and enforces the
+// project quota of every disk tier directory they declare.
+func (e *QuotaEnforcer) syncOnce() {
+	node, err := kubeclient.GetNode(e.KubeClient, e.nodeName)
+	if err != nil {
+		glog.Errorf("project quota enforcer can't read node %s: %v", e.nodeName, err)
+		return
+	}
+
+	runtimes := &datav1alpha1.AlluxioRuntimeList{}
+	if err := e.KubeClient.List(context.TODO(), runtimes); err != nil {
+		glog.Errorf("project quota enforcer can't list runtimes: %v", err)
+		return
+	}
+
+	for i := range runtimes.Items {
+		runtime := &runtimes.Items[i]
+		runtimeInfo, err := base.BuildRuntimeInfo(runtime.Name, runtime.Namespace, common.AlluxioRuntime, runtime.Spec.TieredStore)
+		if err != nil {
+			glog.Errorf("project quota enforcer can't build runtime info for %s/%s: %v", runtime.Namespace, runtime.Name, err)
+			continue
+		}
+		if node.Labels[runtimeInfo.GetCommonLabelName()] != "true" {
+			// the dataset has no cache worker on this node
+			continue
+		}
+		e.enforceRuntime(runtimeInfo)
+	}
+}
+
+// enforceRuntime applies the project quotas of the runtime's disk tier directories on
+// this node.
+func (e *QuotaEnforcer) enforceRuntime(runtimeInfo base.RuntimeInfoInterface) {
+	namespace, name := runtimeInfo.GetNamespace(), runtimeInfo.GetName()
+	for _, level := range runtimeInfo.GetTieredStoreInfo().Levels {
+		if level.MediumType == common.Memory || level.VolumeType != common.VolumeTypeHostPath {
+			continue
+		}
+		for _, cachePath := range level.CachePaths {
+			if cachePath.Quota == nil {
+				continue
+			}
+			dir := fmt.Sprintf("%s/%s/%s", cachePath.Path, namespace, name)
+			projectID := projectIDFor(namespace, name, cachePath.Path)
+			if err := SetProjectQuota(dir, projectID, uint64(cachePath.Quota.Value())); err != nil {
+				glog.Errorf("project quota enforcer can't enforce %v on %s: %v", cachePath.Quota, dir, err)
+				continue
+			}
+			glog.V(4).Infof("enforced project quota %v on %s as project %d", cachePath.Quota, dir, projectID)
+		}
+	}
+}
+
+// projectIDFor derives a stable project ID for the dataset's tier directory.
+func projectIDFor(namespace, name, path string) uint32 {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(namespace + "/" + name + ":" + path))
+	return projectIDBase + hash.Sum32()%projectIDBase
+}
@@ -20,8 +20,12 @@ import (
 	"github.com/golang/glog"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/fluid-cloudnative/fluid/pkg/csi/chaos"
 	"github.com/fluid-cloudnative/fluid/pkg/csi/config"
+	"github.com/fluid-cloudnative/fluid/pkg/csi/discovery"
+	"github.com/fluid-cloudnative/fluid/pkg/csi/janitor"
 	"github.com/fluid-cloudnative/fluid/pkg/csi/plugins"
+	"github.com/fluid-cloudnative/fluid/pkg/csi/projectquota"
 	"github.com/fluid-cloudnative/fluid/pkg/csi/recover"
 	"github.com/fluid-cloudnative/fluid/pkg/csi/updatedbconf"
 )
@@ -39,6 +43,10 @@ func init() {
 	registraions["plugins"] = registrationFuncs{enabled: plugins.Enabled, register: plugins.Register}
 	registraions["recover"] = registrationFuncs{enabled: recover.Enabled, register: recover.Register}
 	registraions["updatedbconf"] = registrationFuncs{enabled: updatedbconf.Enabled, register: updatedbconf.Register}
+	registraions["janitor"] = registrationFuncs{enabled: janitor.Enabled, register: janitor.Register}
+	registraions["discovery"] = registrationFuncs{enabled: discovery.Enabled, register: discovery.Register}
+	registraions["projectquota"] = registrationFuncs{enabled: projectquota.Enabled, register: projectquota.Register}
+	registraions["chaos"] = registrationFuncs{enabled: chaos.Enabled, register: chaos.Register}
 }
 
 // SetupWithManager registers all the enabled components defined in registrations to the controller manager.
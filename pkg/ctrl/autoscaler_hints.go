@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// EmitWorkerScaleUpHints inspects the unschedulable worker pods of the given statefulset
+// and records an event on the runtime describing how many workers can't be placed and what
+// node shape they need (resource requests and node selector), so that the cluster
+// autoscaler and capacity planners can react to fluid's demand for nodes.
+func (e *Helper) EmitWorkerScaleUpHints(recorder record.EventRecorder, runtime base.RuntimeInterface, sts *appsv1.StatefulSet) (err error) {
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("error converting StatefulSet %s in namespace %s selector: %v", sts.Name, sts.Namespace, err)
+	}
+
+	unavailablePods, err := kubeclient.GetUnavailablePodsStatefulSet(e.client, sts, selector)
+	if err != nil {
+		return err
+	}
+
+	var unschedulablePods []*corev1.Pod
+	for _, pod := range unavailablePods {
+		if isUnschedulable(pod) {
+			unschedulablePods = append(unschedulablePods, pod)
+		}
+	}
+
+	if len(unschedulablePods) == 0 {
+		return nil
+	}
+
+	// all worker pods of a statefulset share the same shape, describe it once
+	shape := describePodShape(unschedulablePods[0])
+	recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeWorkersUnschedulable,
+		"%d of %d workers of %s in namespace %s can't be scheduled for lack of suitable nodes, each pending worker needs %s. Scale up nodes of this shape to place them.",
+		len(unschedulablePods),
+		sts.Status.Replicas,
+		sts.Name,
+		sts.Namespace,
+		shape)
+
+	return nil
+}
+
+func isUnschedulable(pod *corev1.Pod) bool {
+	if pod.Status.Phase != corev1.PodPending {
+		return false
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled &&
+			cond.Status == corev1.ConditionFalse &&
+			cond.Reason == corev1.PodReasonUnschedulable {
+			return true
+		}
+	}
+	return false
+}
+
+// describePodShape summarizes the node shape a pending pod requires, e.g.
+// "cpu=4, memory=8Gi with node selector map[disktype:ssd]"
+func describePodShape(pod *corev1.Pod) string {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range container.Resources.Requests {
+			sum := quantity.DeepCopy()
+			if existing, found := requests[name]; found {
+				sum.Add(existing)
+			}
+			requests[name] = sum
+		}
+	}
+
+	parts := make([]string, 0, len(requests))
+	for name := range requests {
+		parts = append(parts, string(name))
+	}
+	sort.Strings(parts)
+	for i, name := range parts {
+		quantity := requests[corev1.ResourceName(name)]
+		parts[i] = fmt.Sprintf("%s=%s", name, (&quantity).String())
+	}
+
+	shape := "no resource requests"
+	if len(parts) > 0 {
+		shape = strings.Join(parts, ", ")
+	}
+	if len(pod.Spec.NodeSelector) > 0 {
+		shape = fmt.Sprintf("%s with node selector %v", shape, pod.Spec.NodeSelector)
+	}
+	return shape
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"strings"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	utilpointer "k8s.io/utils/pointer"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func buildFakeClientForHints(t *testing.T, objs ...crclient.Object) crclient.Client {
+	t.Helper()
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	runtimeObjs := make([]runtime.Object, 0, len(objs))
+	for _, obj := range objs {
+		runtimeObjs = append(runtimeObjs, obj)
+	}
+	return fake.NewFakeClientWithScheme(s, runtimeObjs...)
+}
+
+func TestEmitWorkerScaleUpHints(t *testing.T) {
+	worker := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-worker",
+			Namespace: "fluid",
+			UID:       "uid-hbase-worker",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: utilpointer.Int32Ptr(2),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"role": "alluxio-worker"},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			Replicas:      2,
+			ReadyReplicas: 1,
+		},
+	}
+
+	pendingPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-worker-1",
+			Namespace: "fluid",
+			Labels:    map[string]string{"role": "alluxio-worker"},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       "hbase-worker",
+					UID:        "uid-hbase-worker",
+					Controller: utilpointer.BoolPtr(true),
+				},
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeSelector: map[string]string{"disktype": "ssd"},
+			Containers: []corev1.Container{
+				{
+					Name: "alluxio-worker",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("8Gi"),
+						},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:   corev1.PodScheduled,
+					Status: corev1.ConditionFalse,
+					Reason: corev1.PodReasonUnschedulable,
+				},
+			},
+		},
+	}
+
+	alluxioRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+	}
+
+	runtimeInfo, err := base.BuildRuntimeInfo("hbase", "fluid", "alluxio", datav1alpha1.TieredStore{})
+	if err != nil {
+		t.Fatalf("failed to build runtime info: %v", err)
+	}
+
+	h := BuildHelper(runtimeInfo, buildFakeClientForHints(t, worker, pendingPod, alluxioRuntime), fake.NullLogger())
+	recorder := record.NewFakeRecorder(10)
+
+	err = h.EmitWorkerScaleUpHints(recorder, alluxioRuntime, worker)
+	if err != nil {
+		t.Fatalf("failed to emit scale-up hints: %v", err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		for _, expect := range []string{"WorkersUnschedulable", "cpu=4", "memory=8Gi", "disktype:ssd"} {
+			if !strings.Contains(event, expect) {
+				t.Errorf("expect event to contain %q, got %q", expect, event)
+			}
+		}
+	default:
+		t.Errorf("expect a scale-up hint event, got none")
+	}
+}
+
+func TestEmitWorkerScaleUpHintsNoPendingWorkers(t *testing.T) {
+	worker := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-worker",
+			Namespace: "fluid",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: utilpointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"role": "alluxio-worker"},
+			},
+		},
+	}
+
+	alluxioRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+	}
+
+	runtimeInfo, err := base.BuildRuntimeInfo("hbase", "fluid", "alluxio", datav1alpha1.TieredStore{})
+	if err != nil {
+		t.Fatalf("failed to build runtime info: %v", err)
+	}
+
+	h := BuildHelper(runtimeInfo, buildFakeClientForHints(t, worker, alluxioRuntime), fake.NullLogger())
+	recorder := record.NewFakeRecorder(10)
+
+	err = h.EmitWorkerScaleUpHints(recorder, alluxioRuntime, worker)
+	if err != nil {
+		t.Fatalf("failed to emit scale-up hints: %v", err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		t.Errorf("expect no event when all workers are placed, got %q", event)
+	default:
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// FuseRestartBudgetEnv overrides how many restarts a fuse pod may accumulate before
+	// its node's mount is marked degraded
+	FuseRestartBudgetEnv = "FUSE_RESTART_BUDGET"
+
+	// FuseRestartBudgetEnforceEnv additionally removes the fuse label from nodes whose
+	// fuse pod exhausted the budget, stopping the crash-restart loop there until the
+	// label is restored manually
+	FuseRestartBudgetEnforceEnv = "FUSE_RESTART_BUDGET_ENFORCE"
+
+	defaultFuseRestartBudget = 10
+)
+
+// EnforceFuseRestartBudget tracks the restarts of the runtime's fuse pods and marks the
+// mounts on nodes whose fuse pod restarted more than the budget as degraded, so an
+// infinite crash-restart loop surfaces as a clear condition, event and metric instead of
+// silently flapping between ready and not ready.
+func (e *Helper) EnforceFuseRestartBudget(recorder record.EventRecorder, runtime base.RuntimeInterface,
+	currentStatus datav1alpha1.RuntimeStatus,
+	ds *appsv1.DaemonSet) (degradedNodes []string, err error) {
+	budget := defaultFuseRestartBudget
+	if value, found := utils.GetIntValueFormEnv(FuseRestartBudgetEnv); found && value > 0 {
+		budget = value
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting DaemonSet %s in namespace %s selector: %v", ds.Name, ds.Namespace, err)
+	}
+
+	pods := &corev1.PodList{}
+	err = e.client.List(context.TODO(), pods, &client.ListOptions{
+		Namespace:     ds.Namespace,
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	enforce := utils.GetBoolValueFormEnv(FuseRestartBudgetEnforceEnv, false)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		restarts := maxContainerRestarts(pod)
+		if int(restarts) <= budget || len(pod.Spec.NodeName) == 0 {
+			continue
+		}
+
+		degradedNodes = append(degradedNodes, pod.Spec.NodeName)
+		recorder.Eventf(runtime, corev1.EventTypeWarning, common.FuseRestartBudgetExceeded,
+			"Fuse pod %s on node %s restarted %d times, exceeding the budget of %d, the mount on this node is degraded",
+			pod.Name, pod.Spec.NodeName, restarts, budget)
+		metrics.GetRuntimeMetrics(e.runtimeInfo.GetRuntimeType(), e.runtimeInfo.GetNamespace(), e.runtimeInfo.GetName()).
+			FuseRestartBudgetExceededInc(pod.Spec.NodeName)
+
+		if enforce {
+			// take the node out of the fuse daemonset to stop the crash-restart loop,
+			// restoring the label brings the fuse back
+			if labelErr := e.removeFuseLabelFromNode(pod.Spec.NodeName); labelErr != nil {
+				e.log.Error(labelErr, "Failed to remove the fuse label from the degraded node", "node", pod.Spec.NodeName)
+			}
+		}
+	}
+
+	if len(degradedNodes) == 0 {
+		return
+	}
+
+	// leave a degraded condition on the runtime so the state survives the events
+	statusToUpdate := runtime.GetStatus()
+	cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeFusesDegraded, "The fuses exceeded their restart budget.",
+		fmt.Sprintf("The fuses on nodes %s restarted more than %d times, the mounts there are degraded.",
+			strings.Join(degradedNodes, ","), budget), corev1.ConditionTrue)
+	statusToUpdate.Conditions = utils.UpdateRuntimeCondition(statusToUpdate.Conditions, cond)
+
+	if !reflect.DeepEqual(*statusToUpdate, currentStatus) {
+		err = e.client.Status().Update(context.TODO(), runtime)
+	}
+
+	return
+}
+
+// removeFuseLabelFromNode drops the runtime's fuse label from the node, which drains the
+// fuse daemonset pod from it.
+func (e *Helper) removeFuseLabelFromNode(nodeName string) (err error) {
+	fuseLabelKey := common.LabelAnnotationFusePrefix + e.runtimeInfo.GetNamespace() + "-" + e.runtimeInfo.GetName()
+
+	node := &corev1.Node{}
+	if err = e.client.Get(context.TODO(), client.ObjectKey{Name: nodeName}, node); err != nil {
+		return err
+	}
+
+	var labelsToModify common.LabelsToModify
+	labelsToModify.Delete(fuseLabelKey)
+	_, err = utils.ChangeNodeLabelWithPatchMode(e.client, node, labelsToModify)
+
+	return err
+}
+
+// maxContainerRestarts reports the highest restart count among the pod's containers.
+func maxContainerRestarts(pod *corev1.Pod) (restarts int32) {
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.RestartCount > restarts {
+			restarts = containerStatus.RestartCount
+		}
+	}
+	return
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"strings"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestEnforceFuseRestartBudget(t *testing.T) {
+	alluxioRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+	}
+
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-fuse",
+			Namespace: "fluid",
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"role": "alluxio-fuse"},
+			},
+		},
+	}
+
+	buildFusePod := func(name, nodeName string, restarts int32) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fluid",
+				Labels:    map[string]string{"role": "alluxio-fuse"},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{RestartCount: restarts},
+				},
+			},
+		}
+	}
+
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	client := fake.NewFakeClientWithScheme(s,
+		alluxioRuntime,
+		ds,
+		buildFusePod("hbase-fuse-stable", "node-1", 2),
+		buildFusePod("hbase-fuse-crashloop", "node-2", defaultFuseRestartBudget+1))
+
+	runtimeInfo, err := base.BuildRuntimeInfo("hbase", "fluid", "alluxio", datav1alpha1.TieredStore{})
+	if err != nil {
+		t.Fatalf("fail to create the runtimeInfo with error %v", err)
+	}
+	helper := BuildHelper(runtimeInfo, client, fake.NullLogger())
+
+	recorder := record.NewFakeRecorder(10)
+	degradedNodes, err := helper.EnforceFuseRestartBudget(recorder, alluxioRuntime, *alluxioRuntime.Status.DeepCopy(), ds)
+	if err != nil {
+		t.Fatalf("fail to enforce the fuse restart budget: %v", err)
+	}
+
+	if len(degradedNodes) != 1 || degradedNodes[0] != "node-2" {
+		t.Errorf("expect only node-2 to be degraded, got %v", degradedNodes)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "FuseRestartBudgetExceeded") || !strings.Contains(event, "node-2") {
+			t.Errorf("expect a FuseRestartBudgetExceeded event naming node-2, got %q", event)
+		}
+	default:
+		t.Errorf("expect a FuseRestartBudgetExceeded event, got none")
+	}
+
+	_, cond := utils.GetRuntimeCondition(alluxioRuntime.Status.Conditions, datav1alpha1.RuntimeFusesDegraded)
+	if cond == nil || cond.Status != corev1.ConditionTrue {
+		t.Errorf("expect a FusesDegraded condition on the runtime, got %v", alluxioRuntime.Status.Conditions)
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalePlan reports the predicted effect of changing a runtime's worker replicas
+// before the change is applied.
+type ScalePlan struct {
+	CurrentReplicas int32 `json:"currentReplicas" yaml:"currentReplicas"`
+	DesiredReplicas int32 `json:"desiredReplicas" yaml:"desiredReplicas"`
+
+	// NodesToLoseWorker are the nodes whose workers would be removed on scale-in.
+	// Workers run in a StatefulSet, so the pods with the highest ordinals go first.
+	NodesToLoseWorker []string `json:"nodesToLoseWorker,omitempty" yaml:"nodesToLoseWorker,omitempty"`
+
+	// WorkersToAdd is the number of new workers on scale-out. Their placement is up to
+	// the scheduler, so no node list is predicted for them.
+	WorkersToAdd int32 `json:"workersToAdd,omitempty" yaml:"workersToAdd,omitempty"`
+
+	// CachedBytesToLose estimates the cached data dropped with the removed workers,
+	// assuming the cache is evenly distributed among workers.
+	CachedBytesToLose int64 `json:"cachedBytesToLose" yaml:"cachedBytesToLose"`
+
+	// EstimatedRewarmTime estimates how long re-warming the dropped cache would take
+	// at the given bandwidth.
+	EstimatedRewarmTime time.Duration `json:"estimatedRewarmTime" yaml:"estimatedRewarmTime"`
+}
+
+// PlanWorkerScale simulates scaling the runtime's workers to desiredReplicas and
+// reports which nodes would lose workers, how much cached data would be dropped and an
+// estimate of the time needed to re-warm it at rewarmBandwidthBytesPerSec. Nothing is
+// changed on the cluster.
+func (e *Helper) PlanWorkerScale(runtime base.RuntimeInterface, workers *appsv1.StatefulSet,
+	desiredReplicas int32, rewarmBandwidthBytesPerSec int64) (plan *ScalePlan, err error) {
+	currentReplicas := int32(0)
+	if workers.Spec.Replicas != nil {
+		currentReplicas = *workers.Spec.Replicas
+	}
+	plan = &ScalePlan{
+		CurrentReplicas: currentReplicas,
+		DesiredReplicas: desiredReplicas,
+	}
+
+	if desiredReplicas >= currentReplicas {
+		plan.WorkersToAdd = desiredReplicas - currentReplicas
+		return plan, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(workers.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting StatefulSet %s in namespace %s selector: %v", workers.Name, workers.Namespace, err)
+	}
+	pods, err := kubeclient.GetPodsForStatefulSet(e.client, workers, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	// StatefulSets scale in from the highest ordinal, so the pods losing their workers
+	// are the ones with the largest ordinal suffix. Compare the ordinals numerically:
+	// with 10 or more replicas 'worker-9' sorts after 'worker-10' lexicographically.
+	sort.Slice(pods, func(i, j int) bool {
+		return podOrdinal(pods[i].Name) > podOrdinal(pods[j].Name)
+	})
+	workersToRemove := int(currentReplicas - desiredReplicas)
+	for i := 0; i < len(pods) && i < workersToRemove; i++ {
+		plan.NodesToLoseWorker = append(plan.NodesToLoseWorker, pods[i].Spec.NodeName)
+	}
+
+	// Estimate the dropped cache assuming it's evenly distributed among workers
+	if cached, found := runtime.GetStatus().CacheStates[common.Cached]; found && currentReplicas > 0 {
+		cachedBytes, parseErr := units.RAMInBytes(cached)
+		if parseErr != nil {
+			e.log.Info("can't parse cached bytes when planning scale, skip estimating", "cached", cached, "error", parseErr.Error())
+		} else {
+			plan.CachedBytesToLose = cachedBytes / int64(currentReplicas) * int64(workersToRemove)
+		}
+	}
+
+	if rewarmBandwidthBytesPerSec > 0 && plan.CachedBytesToLose > 0 {
+		plan.EstimatedRewarmTime = time.Duration(plan.CachedBytesToLose/rewarmBandwidthBytesPerSec) * time.Second
+	}
+
+	return plan, nil
+}
+
+// podOrdinal parses the statefulset ordinal suffix of the pod name, or -1 when the
+// name carries none.
+func podOrdinal(podName string) int {
+	idx := strings.LastIndex(podName, "-")
+	if idx < 0 {
+		return -1
+	}
+	ordinal, err := strconv.Atoi(podName[idx+1:])
+	if err != nil {
+		return -1
+	}
+	return ordinal
+}
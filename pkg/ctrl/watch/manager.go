@@ -121,13 +121,13 @@ func isOwnerMatched(controllerRef *metav1.OwnerReference, c Controller) bool {
 	return kind == controllerRef.Kind && apiVersion == controllerRef.APIVersion
 }
 
-func SetupWatcherForWebhook(mgr ctrl.Manager, certBuilder *webhook.CertificateBuilder, caCert []byte) (err error) {
+func SetupWatcherForWebhook(mgr ctrl.Manager, certBuilder *webhook.CertificateBuilder) (err error) {
 	options := controller.Options{}
 	webhookName := common.WebhookName
 	options.Reconciler = &webhookReconcile.WebhookReconciler{
-		CertBuilder: certBuilder,
-		WebhookName: webhookName,
-		CaCert:      caCert,
+		CertBuilder:    certBuilder,
+		PolicyEnforcer: webhook.NewPolicyEnforcer(mgr.GetClient(), log.WithName("policyEnforcer")),
+		WebhookName:    webhookName,
 	}
 	webhookController, err := controller.New("webhook-controller", mgr, options)
 	if err != nil {
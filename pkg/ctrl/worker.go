@@ -130,6 +130,11 @@ func (e *Helper) CheckWorkersHealthy(recorder record.EventRecorder, runtime base
 			unavailablePodNames)
 
 		recorder.Eventf(runtime, corev1.EventTypeWarning, "WorkersUnhealthy", err.Error())
+
+		// 4. Hint node autoscaling when workers stay pending for lack of suitable nodes
+		if hintErr := e.EmitWorkerScaleUpHints(recorder, runtime, sts); hintErr != nil {
+			e.log.Error(hintErr, "failed to emit scale-up hints for unschedulable workers")
+		}
 	}
 
 	status := *statusToUpdate
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataapi
+
+import (
+	"fmt"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DataOperator offers the imperative data verbs of a dataset. The calls proxy directly
+// to the dataset's runtime engine instead of going through CRs and reconciles.
+type DataOperator interface {
+	// Preload loads the data under path into the cache and blocks until done
+	Preload(namespace, name, path string) error
+
+	// Evict frees the cached data under path
+	Evict(namespace, name, path string) error
+
+	// Stats reports the engine's cache usage summary
+	Stats(namespace, name string) (summary string, err error)
+
+	// Flush persists the cached data under path back to the under storage
+	Flush(namespace, name, path string) error
+
+	// CachedPaths lists the paths under path that are in the cache, for exporting a
+	// warm-state manifest
+	CachedPaths(namespace, name, path string) (paths []string, err error)
+}
+
+// the timeout handed to the engine when freeing cached data
+const evictTimeoutSeconds = 60
+
+// engineDataOperator resolves the dataset's bound runtime and execs the matching verb
+// in the engine's master. Only runtimes whose engines offer imperative commands are
+// supported, currently the alluxio runtime.
+type engineDataOperator struct {
+	client client.Client
+	log    logr.Logger
+}
+
+var _ DataOperator = &engineDataOperator{}
+
+func (o *engineDataOperator) fileUtils(namespace, name string) (fileUtils operations.AlluxioFileUtils, err error) {
+	dataset, err := utils.GetDataset(o.client, name, namespace)
+	if err != nil {
+		return fileUtils, err
+	}
+	index, boundRuntime := utils.GetRuntimeByCategory(dataset.Status.Runtimes, common.AccelerateCategory)
+	if index == -1 {
+		return fileUtils, fmt.Errorf("dataset %s in namespace %s is not bound to any runtime yet", name, namespace)
+	}
+	if boundRuntime.Type != common.AlluxioRuntime {
+		return fileUtils, fmt.Errorf("imperative data operations are not supported for runtime type %s", boundRuntime.Type)
+	}
+
+	// the same master pod and container the engine execs its own operations in
+	podName := fmt.Sprintf("%s-master-0", name)
+	return operations.NewAlluxioFileUtils(podName, "alluxio-master", namespace, o.log), nil
+}
+
+func (o *engineDataOperator) Preload(namespace, name, path string) error {
+	fileUtils, err := o.fileUtils(namespace, name)
+	if err != nil {
+		return err
+	}
+	return fileUtils.LoadData(path)
+}
+
+func (o *engineDataOperator) Evict(namespace, name, path string) error {
+	fileUtils, err := o.fileUtils(namespace, name)
+	if err != nil {
+		return err
+	}
+	return fileUtils.CleanCache(path, evictTimeoutSeconds)
+}
+
+func (o *engineDataOperator) Stats(namespace, name string) (summary string, err error) {
+	fileUtils, err := o.fileUtils(namespace, name)
+	if err != nil {
+		return "", err
+	}
+	return fileUtils.ReportSummary()
+}
+
+func (o *engineDataOperator) Flush(namespace, name, path string) error {
+	fileUtils, err := o.fileUtils(namespace, name)
+	if err != nil {
+		return err
+	}
+	return fileUtils.Persist(path)
+}
+
+func (o *engineDataOperator) CachedPaths(namespace, name, path string) (paths []string, err error) {
+	fileUtils, err := o.fileUtils(namespace, name)
+	if err != nil {
+		return paths, err
+	}
+	return fileUtils.CachedFiles(path)
+}
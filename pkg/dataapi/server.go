@@ -0,0 +1,211 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var _ manager.Runnable = &Server{}
+
+// Server exposes the imperative data verbs of datasets over HTTP, so that applications
+// can trigger data actions at runtime without creating CRs and waiting for reconciles.
+// The routes are:
+//
+//	POST /v1/datasets/{namespace}/{name}/preload?path={path}
+//	POST /v1/datasets/{namespace}/{name}/evict?path={path}
+//	GET  /v1/datasets/{namespace}/{name}/stats
+//	POST /v1/datasets/{namespace}/{name}/flush?path={path}
+//	GET  /v1/datasets/{namespace}/{name}/warmstate?path={path}
+//	POST /v1/datasets/{namespace}/{name}/warmstate
+//
+// GET warmstate exports a manifest of the currently cached paths, POST warmstate takes
+// such a manifest as its body and re-warms the cache from it, so CI environments can
+// reproduce a known warm cache without loading the full dataset.
+type Server struct {
+	addr     string
+	log      logr.Logger
+	operator DataOperator
+}
+
+func NewServer(client client.Client, addr string, log logr.Logger) *Server {
+	return &Server{
+		addr: addr,
+		log:  log,
+		operator: &engineDataOperator{
+			client: client,
+			log:    log,
+		},
+	}
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/datasets/", s.serveDataset)
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.log.Info("Starting the dataset data API server", "addr", s.addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		return err
+	}
+}
+
+// serveDataset dispatches /v1/datasets/{namespace}/{name}/{verb} requests.
+func (s *Server) serveDataset(writer http.ResponseWriter, request *http.Request) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(request.URL.Path, "/v1/datasets/"), "/"), "/")
+	if len(segments) != 3 {
+		http.Error(writer, "expect path /v1/datasets/{namespace}/{name}/{verb}", http.StatusNotFound)
+		return
+	}
+	namespace, name, verb := segments[0], segments[1], segments[2]
+
+	path := request.URL.Query().Get("path")
+	if path == "" {
+		path = "/"
+	}
+
+	var err error
+	switch verb {
+	case "preload":
+		if !s.requireMethod(writer, request, http.MethodPost) {
+			return
+		}
+		err = s.operator.Preload(namespace, name, path)
+	case "evict":
+		if !s.requireMethod(writer, request, http.MethodPost) {
+			return
+		}
+		err = s.operator.Evict(namespace, name, path)
+	case "flush":
+		if !s.requireMethod(writer, request, http.MethodPost) {
+			return
+		}
+		err = s.operator.Flush(namespace, name, path)
+	case "stats":
+		if !s.requireMethod(writer, request, http.MethodGet) {
+			return
+		}
+		var summary string
+		if summary, err = s.operator.Stats(namespace, name); err == nil {
+			fmt.Fprintln(writer, summary)
+			return
+		}
+	case "warmstate":
+		switch request.Method {
+		case http.MethodGet:
+			s.serveWarmStateExport(writer, namespace, name, path)
+		case http.MethodPost:
+			s.serveWarmStateImport(writer, request, namespace, name)
+		default:
+			http.Error(writer, "expect method GET or POST", http.StatusMethodNotAllowed)
+		}
+		return
+	default:
+		http.Error(writer, fmt.Sprintf("unknown verb %q, expect preload, evict, stats, flush or warmstate", verb), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		s.log.Error(err, "data operation failed", "verb", verb, "namespace", namespace, "name", name)
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writer.WriteHeader(http.StatusOK)
+}
+
+// WarmStateManifest is the exported warm state of a dataset: the list of cached paths
+// at export time, enough to re-warm a fresh runtime to a comparable state.
+type WarmStateManifest struct {
+	Dataset    string    `json:"dataset"`
+	Namespace  string    `json:"namespace"`
+	ExportedAt time.Time `json:"exportedAt"`
+	Paths      []string  `json:"paths"`
+}
+
+// serveWarmStateExport writes the warm-state manifest of the dataset
+func (s *Server) serveWarmStateExport(writer http.ResponseWriter, namespace, name, path string) {
+	paths, err := s.operator.CachedPaths(namespace, name, path)
+	if err != nil {
+		s.log.Error(err, "fail to export the warm state", "namespace", namespace, "name", name)
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	manifest := WarmStateManifest{
+		Dataset:    name,
+		Namespace:  namespace,
+		ExportedAt: time.Now().UTC(),
+		Paths:      paths,
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(manifest); err != nil {
+		s.log.Error(err, "fail to write the warm-state manifest", "namespace", namespace, "name", name)
+	}
+}
+
+// serveWarmStateImport re-warms the dataset's cache from the manifest in the request body
+func (s *Server) serveWarmStateImport(writer http.ResponseWriter, request *http.Request, namespace, name string) {
+	var manifest WarmStateManifest
+	if err := json.NewDecoder(request.Body).Decode(&manifest); err != nil {
+		http.Error(writer, fmt.Sprintf("fail to decode the warm-state manifest: %v", err), http.StatusBadRequest)
+		return
+	}
+	if len(manifest.Paths) == 0 {
+		http.Error(writer, "the warm-state manifest lists no path", http.StatusBadRequest)
+		return
+	}
+
+	for _, path := range manifest.Paths {
+		if err := s.operator.Preload(namespace, name, path); err != nil {
+			s.log.Error(err, "fail to re-warm from the manifest", "namespace", namespace, "name", name, "path", path)
+			http.Error(writer, fmt.Sprintf("fail to re-warm path %s: %v", path, err), http.StatusInternalServerError)
+			return
+		}
+	}
+	fmt.Fprintf(writer, "re-warmed %d path(s)\n", len(manifest.Paths))
+}
+
+func (s *Server) requireMethod(writer http.ResponseWriter, request *http.Request, method string) bool {
+	if request.Method != method {
+		http.Error(writer, fmt.Sprintf("expect method %s", method), http.StatusMethodNotAllowed)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+// recordingOperator records the verbs dispatched to it instead of execing in an engine.
+type recordingOperator struct {
+	calls []string
+	err   error
+}
+
+var _ DataOperator = &recordingOperator{}
+
+func (o *recordingOperator) record(verb, namespace, name, path string) {
+	o.calls = append(o.calls, fmt.Sprintf("%s %s/%s%s", verb, namespace, name, path))
+}
+
+func (o *recordingOperator) Preload(namespace, name, path string) error {
+	o.record("preload", namespace, name, path)
+	return o.err
+}
+
+func (o *recordingOperator) Evict(namespace, name, path string) error {
+	o.record("evict", namespace, name, path)
+	return o.err
+}
+
+func (o *recordingOperator) Stats(namespace, name string) (string, error) {
+	o.record("stats", namespace, name, "")
+	return "Used Capacity: 1GB", o.err
+}
+
+func (o *recordingOperator) Flush(namespace, name, path string) error {
+	o.record("flush", namespace, name, path)
+	return o.err
+}
+
+func (o *recordingOperator) CachedPaths(namespace, name, path string) ([]string, error) {
+	o.record("cachedpaths", namespace, name, path)
+	return []string{"/train/a", "/train/b"}, o.err
+}
+
+func newTestServer(operator DataOperator) *Server {
+	return &Server{
+		log:      fake.NullLogger(),
+		operator: operator,
+	}
+}
+
+func doRequest(server *Server, method, target, body string) *httptest.ResponseRecorder {
+	var reader *strings.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	} else {
+		reader = strings.NewReader("")
+	}
+	request := httptest.NewRequest(method, target, reader)
+	recorder := httptest.NewRecorder()
+	server.serveDataset(recorder, request)
+	return recorder
+}
+
+func TestServeDatasetVerbDispatch(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		wantStatus int
+		wantCalls  []string
+	}{
+		{
+			name:       "preload with explicit path",
+			method:     http.MethodPost,
+			target:     "/v1/datasets/default/hbase/preload?path=/train",
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"preload default/hbase/train"},
+		},
+		{
+			name:       "evict defaults to the root path",
+			method:     http.MethodPost,
+			target:     "/v1/datasets/default/hbase/evict",
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"evict default/hbase/"},
+		},
+		{
+			name:       "flush",
+			method:     http.MethodPost,
+			target:     "/v1/datasets/default/hbase/flush?path=/out",
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"flush default/hbase/out"},
+		},
+		{
+			name:       "stats",
+			method:     http.MethodGet,
+			target:     "/v1/datasets/default/hbase/stats",
+			wantStatus: http.StatusOK,
+			wantCalls:  []string{"stats default/hbase"},
+		},
+		{
+			name:       "mutating verb refuses GET",
+			method:     http.MethodGet,
+			target:     "/v1/datasets/default/hbase/preload",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "unknown verb",
+			method:     http.MethodPost,
+			target:     "/v1/datasets/default/hbase/defrag",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "malformed path",
+			method:     http.MethodPost,
+			target:     "/v1/datasets/default/preload",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		operator := &recordingOperator{}
+		recorder := doRequest(newTestServer(operator), tt.method, tt.target, "")
+		if recorder.Code != tt.wantStatus {
+			t.Errorf("testcase %s: got status %d, want %d, body: %s", tt.name, recorder.Code, tt.wantStatus, recorder.Body.String())
+		}
+		if !reflect.DeepEqual(operator.calls, tt.wantCalls) && !(len(operator.calls) == 0 && len(tt.wantCalls) == 0) {
+			t.Errorf("testcase %s: operator calls = %v, want %v", tt.name, operator.calls, tt.wantCalls)
+		}
+	}
+}
+
+func TestServeDatasetOperationError(t *testing.T) {
+	operator := &recordingOperator{err: errors.New("the master pod is gone")}
+	recorder := doRequest(newTestServer(operator), http.MethodPost, "/v1/datasets/default/hbase/preload", "")
+	if recorder.Code != http.StatusInternalServerError {
+		t.Errorf("got status %d, want %d", recorder.Code, http.StatusInternalServerError)
+	}
+	if !strings.Contains(recorder.Body.String(), "the master pod is gone") {
+		t.Errorf("the response doesn't surface the operation error, body: %s", recorder.Body.String())
+	}
+}
+
+func TestServeWarmStateExport(t *testing.T) {
+	operator := &recordingOperator{}
+	recorder := doRequest(newTestServer(operator), http.MethodGet, "/v1/datasets/default/hbase/warmstate?path=/train", "")
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d, body: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+	}
+
+	var manifest WarmStateManifest
+	if err := json.NewDecoder(recorder.Body).Decode(&manifest); err != nil {
+		t.Fatalf("fail to decode the warm-state manifest: %v", err)
+	}
+	if manifest.Dataset != "hbase" || manifest.Namespace != "default" {
+		t.Errorf("manifest identifies %s/%s, want default/hbase", manifest.Namespace, manifest.Dataset)
+	}
+	if !reflect.DeepEqual(manifest.Paths, []string{"/train/a", "/train/b"}) {
+		t.Errorf("manifest paths = %v, want the cached paths", manifest.Paths)
+	}
+	if !reflect.DeepEqual(operator.calls, []string{"cachedpaths default/hbase/train"}) {
+		t.Errorf("operator calls = %v", operator.calls)
+	}
+}
+
+func TestServeWarmStateImport(t *testing.T) {
+	operator := &recordingOperator{}
+	manifest := `{"dataset":"hbase","namespace":"default","paths":["/train/a","/train/b"]}`
+	recorder := doRequest(newTestServer(operator), http.MethodPost, "/v1/datasets/default/hbase/warmstate", manifest)
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d, body: %s", recorder.Code, http.StatusOK, recorder.Body.String())
+	}
+	wantCalls := []string{"preload default/hbase/train/a", "preload default/hbase/train/b"}
+	if !reflect.DeepEqual(operator.calls, wantCalls) {
+		t.Errorf("operator calls = %v, want %v", operator.calls, wantCalls)
+	}
+
+	// a manifest listing no path is refused
+	recorder = doRequest(newTestServer(&recordingOperator{}), http.MethodPost, "/v1/datasets/default/hbase/warmstate", `{"paths":[]}`)
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("got status %d for an empty manifest, want %d", recorder.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataarchive
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DataArchiveValue defines the value yaml file used in DataArchive helm chart
+type DataArchiveValue struct {
+	DataArchive DataArchive `yaml:"dataArchive"`
+}
+
+// DataArchive defines values used in DataArchive helm chart
+type DataArchive struct {
+	Namespace          string   `yaml:"namespace,omitempty"`
+	Dataset            string   `yaml:"dataset,omitempty"`
+	Name               string   `yaml:"name,omitempty"`
+	Image              string   `yaml:"image,omitempty"`
+	JavaEnv            string   `yaml:"javaEnv,omitempty"`
+	Paths              []string `yaml:"paths,omitempty"`
+	ColdAfterDays      int32    `yaml:"coldAfterDays,omitempty"`
+	TargetStorageClass string   `yaml:"targetStorageClass,omitempty"`
+	DryRun             bool     `yaml:"dryRun,omitempty"`
+	// image pull secrets
+	ImagePullSecrets []corev1.LocalObjectReference `yaml:"imagePullSecrets,omitempty"`
+}
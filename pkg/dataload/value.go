@@ -45,6 +45,17 @@ type DataLoadInfo struct {
 	// Options specifies the extra dataload properties for runtime
 	Options map[string]string `json:"options,omitempty"`
 
+	// ManifestPath specifies the checksum manifest within the dataset the loaded files
+	// are verified against, verification is skipped when empty
+	ManifestPath string `json:"manifestPath,omitempty"`
+
+	// ChecksumAlgorithm specifies the algorithm of the checksums in the manifest
+	ChecksumAlgorithm string `json:"checksumAlgorithm,omitempty"`
+
+	// FailOnChecksumMismatch specifies if the DataLoad job should fail on a mismatch
+	// instead of only reporting it
+	FailOnChecksumMismatch bool `json:"failOnChecksumMismatch,omitempty"`
+
 	// Labels defines labels in DataLoad's pod metadata
 	Labels map[string]string `json:"labels,omitempty"`
 
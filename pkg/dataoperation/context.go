@@ -25,7 +25,8 @@ import (
 type OperationType string
 
 const (
-	DataBackup OperationType = "DataBackup"
+	DataBackup  OperationType = "DataBackup"
+	DataArchive OperationType = "DataArchive"
 )
 
 // ReconcileRequestContext loads or applys the configuration state of a service.
@@ -23,7 +23,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// OperationInterface the interface of data operation crd
+// OperationInterface is the extension point of the data operation framework. Built-in
+// operations like DataBackup implement it, and custom operation types (e.g. DataIndex)
+// compiled into the dataset controller can implement it as well and register their
+// reconciler with RegisterOperationReconcilerBuilder. Implementations describe an
+// operation's own bits (target dataset, helm chart, status fields) while the shared
+// status machine, dataset locking and policy handling stay in the framework.
 type OperationInterface interface {
 	// GetTargetDatasetNamespacedName get the target dataeset namespace and name of the data operation
 	GetTargetDatasetNamespacedName(object client.Object) (*types.NamespacedName, error)
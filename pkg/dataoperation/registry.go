@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+// OperationReconcilerInterface is implemented by the reconciler of a data operation so
+// that the controller manager can set it up like the built-in ones.
+type OperationReconcilerInterface interface {
+	// SetupWithManager registers the reconciler to the controller manager
+	SetupWithManager(mgr ctrl.Manager, options controller.Options) error
+}
+
+// OperationReconcilerBuilder constructs the reconciler of a custom data operation type.
+// The returned reconciler usually embeds controllers.OperationReconciler with an
+// OperationInterface implementation, so it reuses the shared status machine, the lock
+// on the target dataset and the helm release handling for free.
+type OperationReconcilerBuilder func(client client.Client, log logr.Logger, scheme *runtime.Scheme, recorder record.EventRecorder) OperationReconcilerInterface
+
+var (
+	builderMutex sync.Mutex
+	builders     = map[OperationType]OperationReconcilerBuilder{}
+)
+
+// RegisterOperationReconcilerBuilder registers the builder of a custom data operation
+// type (e.g. DataIndex) compiled into the dataset controller, typically from an init()
+// in the plugin's package. Registering a duplicate operation type returns an error.
+func RegisterOperationReconcilerBuilder(operationType OperationType, builder OperationReconcilerBuilder) error {
+	builderMutex.Lock()
+	defer builderMutex.Unlock()
+	if _, found := builders[operationType]; found {
+		return fmt.Errorf("operation type %s is already registered", operationType)
+	}
+	builders[operationType] = builder
+	return nil
+}
+
+// ForEachOperationReconcilerBuilder visits the registered builders in a deterministic
+// order so the controller manager can set up all the custom data operations.
+func ForEachOperationReconcilerBuilder(visit func(operationType OperationType, builder OperationReconcilerBuilder) error) error {
+	builderMutex.Lock()
+	defer builderMutex.Unlock()
+	operationTypes := make([]string, 0, len(builders))
+	for operationType := range builders {
+		operationTypes = append(operationTypes, string(operationType))
+	}
+	sort.Strings(operationTypes)
+	for _, operationType := range operationTypes {
+		if err := visit(OperationType(operationType), builders[OperationType(operationType)]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataarchive "github.com/fluid-cloudnative/fluid/pkg/dataarchive"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"gopkg.in/yaml.v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// generateDataArchiveValueFile builds the value yaml file consumed by the fluid-dataarchive
+// helm chart, which runs a job that frees cold files from the cache tiers.
+func (e *AlluxioEngine) generateDataArchiveValueFile(ctx cruntime.ReconcileRequestContext, object client.Object) (valueFileName string, err error) {
+	dataArchive, ok := object.(*datav1alpha1.DataArchive)
+	if !ok {
+		err = fmt.Errorf("object %v is not a DataArchive", object)
+		return "", err
+	}
+
+	masterPodName, _ := e.getMasterPodInfo()
+	masterPod, err := e.getMasterPod(masterPodName, e.namespace)
+	if err != nil {
+		return
+	}
+	_, ip, rpcPort := utils.GetAddressOfMaster(masterPod)
+	javaEnv := "-Dalluxio.master.hostname=" + ip + " -Dalluxio.master.rpc.port=" + strconv.Itoa(int(rpcPort))
+
+	imageName, imageTag := docker.GetWorkerImage(e.Client, dataArchive.Spec.Dataset.Name, common.AlluxioRuntime, dataArchive.Namespace)
+	if len(imageName) == 0 {
+		imageName = docker.GetImageRepoFromEnv(common.AlluxioRuntimeImageEnv)
+		if len(imageName) == 0 {
+			imageName = strings.Split(common.DefaultAlluxioRuntimeImage, ":")[0]
+		}
+	}
+	if len(imageTag) == 0 {
+		imageTag = docker.GetImageTagFromEnv(common.AlluxioRuntimeImageEnv)
+		if len(imageTag) == 0 {
+			imageTag = strings.Split(common.DefaultAlluxioRuntimeImage, ":")[1]
+		}
+	}
+
+	paths := dataArchive.Spec.Paths
+	if len(paths) == 0 {
+		paths = []string{"/"}
+	}
+	coldAfterDays := dataArchive.Spec.ColdAfterDays
+	if coldAfterDays <= 0 {
+		coldAfterDays = 30
+	}
+
+	dataArchiveValue := cdataarchive.DataArchiveValue{
+		DataArchive: cdataarchive.DataArchive{
+			Namespace:          dataArchive.Namespace,
+			Dataset:            dataArchive.Spec.Dataset.Name,
+			Name:               dataArchive.Name,
+			Image:              fmt.Sprintf("%s:%s", imageName, imageTag),
+			JavaEnv:            javaEnv,
+			Paths:              paths,
+			ColdAfterDays:      coldAfterDays,
+			TargetStorageClass: dataArchive.Spec.TargetStorageClass,
+			DryRun:             dataArchive.Spec.DryRun,
+			ImagePullSecrets:   docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey),
+		},
+	}
+
+	data, err := yaml.Marshal(dataArchiveValue)
+	if err != nil {
+		return
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-archive-values.yaml", dataArchive.Namespace, dataArchive.Name))
+	if err != nil {
+		return
+	}
+	err = os.WriteFile(valueFile.Name(), data, 0400)
+	if err != nil {
+		return
+	}
+	return valueFile.Name(), nil
+}
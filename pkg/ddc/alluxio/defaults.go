@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/defaults"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+)
+
+const metadataSyncIntervalProperty = "alluxio.user.file.metadata.sync.interval"
+
+// applyEffectiveDefaults fills the settings the runtime spec leaves unset from the
+// layered cluster/namespace defaults, so the rest of the transform sees them as if the
+// user had declared them. Spec values always win; the hard-coded and env-based fallbacks
+// in the image parsing only kick in when no layer defaults the setting either.
+func (e *AlluxioEngine) applyEffectiveDefaults(runtime *datav1alpha1.AlluxioRuntime) error {
+	effective, err := defaults.LoadEffective(e.Client, runtime.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if len(effective.RuntimeImage) > 0 &&
+		len(runtime.Spec.AlluxioVersion.Image) == 0 && len(runtime.Spec.AlluxioVersion.ImageTag) == 0 {
+		runtime.Spec.AlluxioVersion.Image, runtime.Spec.AlluxioVersion.ImageTag = docker.ParseDockerImage(effective.RuntimeImage)
+	}
+
+	if len(effective.FuseImage) > 0 &&
+		len(runtime.Spec.Fuse.Image) == 0 && len(runtime.Spec.Fuse.ImageTag) == 0 {
+		runtime.Spec.Fuse.Image, runtime.Spec.Fuse.ImageTag = docker.ParseDockerImage(effective.FuseImage)
+	}
+
+	if len(effective.MetadataSyncInterval) > 0 {
+		if _, found := runtime.Spec.Properties[metadataSyncIntervalProperty]; !found {
+			if runtime.Spec.Properties == nil {
+				runtime.Spec.Properties = map[string]string{}
+			}
+			runtime.Spec.Properties[metadataSyncIntervalProperty] = effective.MetadataSyncInterval
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	// WorkerDrainTimeoutEnv overrides the bounded period a decommissioned worker gets to
+	// finish its in-flight client streams before its pod is removed on scale-in
+	WorkerDrainTimeoutEnv = "WORKER_DRAIN_TIMEOUT"
+
+	defaultWorkerDrainTimeout = 30 * time.Second
+)
+
+// drainWorkerOnNode decommissions the alluxio worker on the given node ahead of its
+// removal on scale-in, so fuse clients stop selecting the worker for new reads, then
+// grants in-flight streams a bounded period to finish instead of cutting them off and
+// surfacing read errors to applications. Draining is best effort: scale-in proceeds
+// either way, a failed drain merely keeps the abrupt removal behavior.
+func (e *AlluxioEngine) drainWorkerOnNode(nodeName string) {
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+	if !fileUtils.Ready() {
+		e.Log.Info("The master is not ready, skip draining the worker before scale-in", "node", nodeName)
+		return
+	}
+
+	if err := fileUtils.DecommissionWorker(nodeName); err != nil {
+		e.Log.Info("Fail to decommission the worker before scale-in, will remove it undrained",
+			"node", nodeName, "error", err)
+		return
+	}
+
+	// alluxio exposes no per-worker stream count through its CLI, so the decommissioned
+	// worker gets a fixed bounded period for its in-flight streams instead of a poll
+	drainTimeout := utils.GetDurationValueFromEnv(WorkerDrainTimeoutEnv, defaultWorkerDrainTimeout)
+	e.Log.Info("Worker is decommissioned, waiting for its in-flight streams to finish",
+		"node", nodeName, "drainTimeout", drainTimeout)
+	time.Sleep(drainTimeout)
+}
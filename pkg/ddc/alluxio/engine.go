@@ -15,6 +15,7 @@ package alluxio
 
 import (
 	"fmt"
+	"sync"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -41,9 +42,12 @@ type AlluxioEngine struct {
 	retryShutdown      int32
 	initImage          string
 	MetadataSyncDoneCh chan base.MetadataSyncResult
-	runtimeInfo        base.RuntimeInfoInterface
-	UnitTest           bool
-	lastCacheHitStates *cacheHitStates
+	// metadataSyncCheckpoint records metadata sync shards that are already loaded,
+	// so a sharded sync can resume incrementally after an interruption
+	metadataSyncCheckpoint *sync.Map
+	runtimeInfo            base.RuntimeInfoInterface
+	UnitTest               bool
+	lastCacheHitStates     *cacheHitStates
 	*ctrl.Helper
 	Recorder record.EventRecorder
 }
@@ -59,9 +63,10 @@ func Build(id string, ctx cruntime.ReconcileRequestContext) (base.Engine, error)
 		runtimeType: ctx.RuntimeType,
 		// defaultGracefulShutdownLimits:       5,
 		// defaultCleanCacheGracePeriodSeconds: 60,
-		retryShutdown:      0,
-		MetadataSyncDoneCh: nil,
-		lastCacheHitStates: nil,
+		retryShutdown:          0,
+		MetadataSyncDoneCh:     nil,
+		metadataSyncCheckpoint: &sync.Map{},
+		lastCacheHitStates:     nil,
 	}
 	// var implement base.Implement = engine
 	// engine.TemplateEngine = template
@@ -47,7 +47,13 @@ func (e *AlluxioEngine) CheckRuntimeHealthy() (err error) {
 		return
 	}
 
-	// 2. Check the healthy of the workers
+	// 2. Reconcile workers lost with removed nodes so their pods get rescheduled and
+	// the cache accounting doesn't drift
+	if reconcileErr := e.reconcileLostWorkers(); reconcileErr != nil {
+		e.Log.Error(reconcileErr, "Failed to reconcile workers on removed nodes")
+	}
+
+	// 3. Check the healthy of the workers
 	err = e.checkWorkersHealthy()
 	if err != nil {
 		e.Log.Error(err, "The workers are not healthy")
@@ -58,7 +64,7 @@ func (e *AlluxioEngine) CheckRuntimeHealthy() (err error) {
 		return
 	}
 
-	// 3. Check the healthy of the fuse
+	// 4. Check the healthy of the fuse
 	err = e.checkFuseHealthy()
 	if err != nil {
 		e.Log.Error(err, "The fuse is not healthy")
@@ -252,6 +258,14 @@ func (e *AlluxioEngine) checkFuseHealthy() (err error) {
 		return err
 	}
 
+	// enforce the fuse restart budget first so a crash-looping fuse surfaces as a
+	// degraded mount instead of endlessly flapping between ready and not ready
+	if runtime, budgetErr := e.getRuntime(); budgetErr == nil {
+		if _, budgetErr = e.EnforceFuseRestartBudget(e.Recorder, runtime, *runtime.Status.DeepCopy(), fuses); budgetErr != nil {
+			e.Log.Error(budgetErr, "Failed to enforce the fuse restart budget")
+		}
+	}
+
 	healthy := false
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 
@@ -163,6 +163,16 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 		dataloadInfo.SchedulerName = dataload.Spec.SchedulerName
 	}
 
+	// checksum manifest to verify the loaded files against
+	if manifest := dataload.Spec.VerificationManifest; manifest != nil {
+		dataloadInfo.ManifestPath = manifest.Path
+		dataloadInfo.ChecksumAlgorithm = manifest.Algorithm
+		if len(dataloadInfo.ChecksumAlgorithm) == 0 {
+			dataloadInfo.ChecksumAlgorithm = "sha256"
+		}
+		dataloadInfo.FailOnChecksumMismatch = manifest.FailOnMismatch
+	}
+
 	targetPaths := []cdataload.TargetPath{}
 	for _, target := range dataload.Spec.Target {
 		fluidNative := utils.IsTargetPathUnderFluidNativeMounts(target.Path, *targetDataset)
@@ -526,6 +526,47 @@ func Test_genDataLoadValue(t *testing.T) {
 	}
 }
 
+func Test_genDataLoadValueWithVerificationManifest(t *testing.T) {
+	targetDataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataset",
+			Namespace: "fluid",
+		},
+	}
+	dataload := datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-dataload",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{
+				Name:      "test-dataset",
+				Namespace: "fluid",
+			},
+			VerificationManifest: &datav1alpha1.ChecksumManifest{
+				Path:           "/checksums.sha256",
+				FailOnMismatch: true,
+			},
+		},
+	}
+
+	engine := AlluxioEngine{
+		namespace: "fluid",
+		name:      "test",
+		Log:       fake.NullLogger(),
+	}
+	got := engine.genDataLoadValue("fluid:v0.0.1", targetDataset, dataload)
+	if got.DataLoadInfo.ManifestPath != "/checksums.sha256" {
+		t.Errorf("expect the manifest path to propagate, got %s", got.DataLoadInfo.ManifestPath)
+	}
+	if got.DataLoadInfo.ChecksumAlgorithm != "sha256" {
+		t.Errorf("expect the checksum algorithm to default to sha256, got %s", got.DataLoadInfo.ChecksumAlgorithm)
+	}
+	if !got.DataLoadInfo.FailOnChecksumMismatch {
+		t.Errorf("expect failOnMismatch to propagate")
+	}
+}
+
 func TestCheckRuntimeReady(t *testing.T) {
 	mockExecCommon := func(podName string, containerName string, namespace string, cmd []string) (stdout string, stderr string, e error) {
 		return "", "", nil
@@ -16,16 +16,12 @@ limitations under the License.
 package alluxio
 
 import (
-	"fmt"
-	"os"
-
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubectl"
-	"sigs.k8s.io/yaml"
 )
 
 // setup the cache master
@@ -78,26 +74,13 @@ func (e *AlluxioEngine) generateAlluxioValueFile(runtime *datav1alpha1.AlluxioRu
 
 	e.Log.Info("Generate values", "value", value)
 
-	data, err := yaml.Marshal(value)
-	if err != nil {
-		return
-	}
-
-	//2. Get the template value file
-	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-values.yaml", e.name, e.runtimeType))
+	//2. Get the template value file, reuse the last rendered one when values are unchanged
+	valueFileName, _, err = helm.GenerateValueFileWithCache(e.name, e.namespace, value)
 	if err != nil {
-		e.Log.Error(err, "failed to create value file", "valueFile", valueFile.Name())
+		e.Log.Error(err, "failed to generate value file", "valueFile", valueFileName)
 		return valueFileName, err
 	}
 
-	valueFileName = valueFile.Name()
-	e.Log.V(1).Info("Save the values file", "valueFile", valueFileName)
-
-	err = os.WriteFile(valueFileName, data, 0400)
-	if err != nil {
-		return
-	}
-
 	//3. Save the configfile into configmap
 	err = kubectl.CreateConfigMapFromFile(e.getConfigmapName(), "data", valueFileName, e.namespace)
 	if err != nil {
@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"sync"
 	"time"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
@@ -296,7 +298,11 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 				}
 			}
 			// load metadata
-			err = fileUtils.LoadMetadataWithoutTimeout("/")
+			if e.runtime.Spec.RuntimeManagement.MetadataSyncPolicy.ShardingEnabled() {
+				err = e.loadMetadataInShards(fileUtils, dataset, e.runtime.Spec.RuntimeManagement.MetadataSyncPolicy.Sharding)
+			} else {
+				err = fileUtils.LoadMetadataWithoutTimeout("/")
+			}
 			if err != nil {
 				e.Log.Error(err, "LoadMetadata failed when syncing metadata", "name", e.name, "namespace", e.namespace)
 				result.Err = err
@@ -335,3 +341,69 @@ func (e *AlluxioEngine) syncMetadataInternal() (err error) {
 	}
 	return
 }
+
+// loadMetadataInShards loads metadata mount by mount with bounded concurrency instead of
+// one single-threaded full listing from the root. When checkpointing is enabled, finished
+// shards are recorded on the engine so that the next attempt of an interrupted sync only
+// lists the unfinished ones.
+func (e *AlluxioEngine) loadMetadataInShards(fileUtils operations.AlluxioFileUtils, dataset *datav1alpha1.Dataset, sharding *datav1alpha1.MetadataSyncSharding) (err error) {
+	shards := []string{}
+	for _, mount := range dataset.Spec.Mounts {
+		shards = append(shards, utils.UFSPathBuilder{}.GenAlluxioMountPath(mount, dataset.Spec.Mounts))
+	}
+	if len(shards) == 0 {
+		return fileUtils.LoadMetadataWithoutTimeout("/")
+	}
+
+	concurrency := int(sharding.GetConcurrency())
+	if concurrency > len(shards) {
+		concurrency = len(shards)
+	}
+	e.Log.Info("Loading metadata in shards", "shards", shards, "concurrency", concurrency)
+
+	var (
+		wg      sync.WaitGroup
+		mutex   sync.Mutex
+		shardCh = make(chan string)
+	)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for shard := range shardCh {
+				if sharding.CheckpointEnabled() {
+					if _, done := e.metadataSyncCheckpoint.Load(shard); done {
+						e.Log.V(1).Info("Skip checkpointed shard when loading metadata", "shard", shard)
+						continue
+					}
+				}
+				if loadErr := fileUtils.LoadMetadataWithoutTimeout(shard); loadErr != nil {
+					e.Log.Error(loadErr, "Failed to load metadata of shard", "shard", shard)
+					mutex.Lock()
+					if err == nil {
+						err = loadErr
+					}
+					mutex.Unlock()
+					continue
+				}
+				if sharding.CheckpointEnabled() {
+					e.metadataSyncCheckpoint.Store(shard, true)
+				}
+			}
+		}()
+	}
+	for _, shard := range shards {
+		shardCh <- shard
+	}
+	close(shardCh)
+	wg.Wait()
+
+	// All the shards are loaded, clear the checkpoint for future full syncs
+	if err == nil {
+		e.metadataSyncCheckpoint.Range(func(key, value interface{}) bool {
+			e.metadataSyncCheckpoint.Delete(key)
+			return true
+		})
+	}
+	return
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcileLostWorkers detects workers whose node has been deleted, deletes the stuck
+// worker pods so the statefulset controller can schedule replacements on the remaining
+// nodes, and refreshes the cached-bytes accounting which otherwise silently drifts after
+// a node removal. Every lost worker leaves an event trail on the runtime.
+func (e *AlluxioEngine) reconcileLostWorkers() (err error) {
+	workerName := e.getWorkerName()
+	workers, err := kubeclient.GetStatefulSet(e.Client, workerName, e.namespace)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(workers.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("error converting StatefulSet %s in namespace %s selector: %v", workers.Name, workers.Namespace, err)
+	}
+
+	pods, err := kubeclient.GetPodsForStatefulSet(e.Client, workers, selector)
+	if err != nil {
+		return err
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return err
+	}
+
+	lostWorkers := 0
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+		_, err = kubeclient.GetNode(e.Client, pod.Spec.NodeName)
+		if err == nil {
+			continue
+		}
+		if !apierrs.IsNotFound(err) {
+			return err
+		}
+
+		// the node hosting the worker is gone, the pod can never come back by itself
+		e.Log.Info("found a worker on a removed node, delete it for rescheduling",
+			"pod", pod.Name, "node", pod.Spec.NodeName)
+		e.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimeWorkerNodeLost,
+			"Worker %s lost with the removal of node %s, its cached data is gone and the worker will be rescheduled", pod.Name, pod.Spec.NodeName)
+
+		if err = e.Client.Delete(context.TODO(), pod); err != nil && !apierrs.IsNotFound(err) {
+			return err
+		}
+		lostWorkers++
+	}
+
+	if lostWorkers == 0 {
+		return nil
+	}
+
+	// re-sync the cached-bytes accounting with the master's post-loss view
+	if err = e.UpdateCacheOfDataset(); err != nil {
+		return err
+	}
+	e.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.RuntimeCacheStateRefreshed,
+		"Cache state refreshed after losing %d worker(s), consider a DataLoad to re-warm the lost blocks", lostWorkers)
+
+	return nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	utilpointer "k8s.io/utils/pointer"
+)
+
+func TestReconcileLostWorkers(t *testing.T) {
+	workers := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "StatefulSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-worker",
+			Namespace: "fluid",
+			UID:       "uid-hbase-worker",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: utilpointer.Int32Ptr(2),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"role": "alluxio-worker"},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			Replicas:      2,
+			ReadyReplicas: 1,
+		},
+	}
+
+	buildWorkerPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fluid",
+				Labels:    map[string]string{"role": "alluxio-worker"},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "hbase-worker",
+						UID:        "uid-hbase-worker",
+						Controller: utilpointer.BoolPtr(true),
+					},
+				},
+			},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+			},
+		}
+	}
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "node-alive",
+		},
+	}
+
+	alluxioRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+	}
+
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+	}
+
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	client := fake.NewFakeClientWithScheme(s,
+		workers,
+		buildWorkerPod("hbase-worker-0", "node-alive"),
+		buildWorkerPod("hbase-worker-1", "node-removed"),
+		node,
+		alluxioRuntime,
+		dataset)
+
+	recorder := record.NewFakeRecorder(10)
+	engine := AlluxioEngine{
+		Client:    client,
+		Log:       fake.NullLogger(),
+		Recorder:  recorder,
+		namespace: "fluid",
+		name:      "hbase",
+		runtime:   alluxioRuntime,
+	}
+
+	err := engine.reconcileLostWorkers()
+	if err != nil {
+		t.Fatalf("failed to reconcile lost workers: %v", err)
+	}
+
+	// the worker on the removed node should be deleted for rescheduling
+	pod := &corev1.Pod{}
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-worker-1"}, pod)
+	if !apierrs.IsNotFound(err) {
+		t.Errorf("expect the worker on the removed node to be deleted, got err %v", err)
+	}
+
+	// the worker on the living node should stay
+	err = client.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase-worker-0"}, pod)
+	if err != nil {
+		t.Errorf("expect the worker on the living node to stay, got err %v", err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, "WorkerNodeLost") || !strings.Contains(event, "node-removed") {
+			t.Errorf("expect a WorkerNodeLost event naming the removed node, got %q", event)
+		}
+	default:
+		t.Errorf("expect a WorkerNodeLost event, got none")
+	}
+}
@@ -32,6 +32,11 @@ func (e *AlluxioEngine) GetDataOperationValueFile(ctx cruntime.ReconcileRequestC
 		return valueFileName, err
 	}
 
+	if operationType == dataoperation.DataArchive {
+		valueFileName, err = e.generateDataArchiveValueFile(ctx, object)
+		return valueFileName, err
+	}
+
 	return "", errors.NewNotSupported(
 		schema.GroupResource{
 			Group:    object.GetObjectKind().GroupVersionKind().Group,
@@ -87,6 +87,24 @@ func (a AlluxioFileUtils) ReportSummary() (summary string, err error) {
 	return stdout, err
 }
 
+// DecommissionWorker marks the worker with the given host as decommissioning, so that
+// clients stop selecting it for new reads while its in-flight streams keep being served
+func (a AlluxioFileUtils) DecommissionWorker(workerHost string) (err error) {
+	var (
+		command = []string{"alluxio", "fsadmin", "decommissionWorker", "--addresses", workerHost}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	return
+}
+
 // Load the metadata without timeout
 func (a AlluxioFileUtils) LoadMetadataWithoutTimeout(alluxioPath string) (err error) {
 	var (
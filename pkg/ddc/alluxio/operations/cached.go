@@ -59,6 +59,82 @@ func (a AlluxioFileUtils) CachedState() (cached int64, err error) {
 	return
 }
 
+// CachedFiles lists the files under the given alluxio path that are at least partially
+// in the cache, so the warm state can be exported and reproduced elsewhere.
+func (a AlluxioFileUtils) CachedFiles(alluxioPath string) (paths []string, err error) {
+	var (
+		command = []string{"alluxio", "fs", "ls", "-R", alluxioPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.execWithoutTimeout(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	return parseCachedFiles(stdout), nil
+}
+
+// parseCachedFiles picks the paths with a positive in-cache percentage out of the
+// output of `alluxio fs ls -R`, whose rows look like:
+//
+//	-rw-r--r-- user group 1024 PERSISTED 06-01-2023 12:00:00:000 100% /path/file
+func parseCachedFiles(stdout string) (paths []string) {
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		percentField := fields[len(fields)-2]
+		if !strings.HasSuffix(percentField, "%") {
+			continue
+		}
+		percent, err := strconv.Atoi(strings.TrimSuffix(percentField, "%"))
+		if err != nil || percent <= 0 {
+			continue
+		}
+		paths = append(paths, fields[len(fields)-1])
+	}
+	return
+}
+
+// LoadData loads the data of the given alluxio path into the cache with a distributed
+// load. The call returns only when the load finishes, so it's executed without timeout.
+func (a AlluxioFileUtils) LoadData(alluxioPath string) (err error) {
+	var (
+		command = []string{"alluxio", "fs", "distributedLoad", alluxioPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.execWithoutTimeout(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	return
+}
+
+// Persist flushes the cached data of the given alluxio path back to the under storage.
+func (a AlluxioFileUtils) Persist(alluxioPath string) (err error) {
+	var (
+		command = []string{"alluxio", "fs", "persist", alluxioPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.execWithoutTimeout(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	return
+}
+
 // clean cache with a preset timeout of 60s
 func (a AlluxioFileUtils) CleanCache(path string, timeout int32) (err error) {
 	var (
@@ -18,6 +18,7 @@ package operations
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/brahma-adshonor/gohook"
@@ -111,3 +112,16 @@ func TestAlluxioFIlUtils_CleanCache(t *testing.T) {
 	}
 	wrappedUnhookExec()
 }
+
+func TestParseCachedFiles(t *testing.T) {
+	stdout := `drwxr-xr-x  user group 3 PERSISTED 06-01-2023 12:00:00:000  DIR /reports
+-rw-r--r--  user group 1024 PERSISTED 06-01-2023 12:00:00:000 100% /reports/daily.csv
+-rw-r--r--  user group 2048 PERSISTED 06-01-2023 12:00:01:000 50% /reports/weekly.csv
+-rw-r--r--  user group 4096 PERSISTED 06-01-2023 12:00:02:000 0% /reports/cold.csv`
+
+	paths := parseCachedFiles(stdout)
+	want := []string{"/reports/daily.csv", "/reports/weekly.csv"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("parseCachedFiles() = %v, want %v", paths, want)
+	}
+}
@@ -45,7 +45,13 @@ func (e *AlluxioEngine) Shutdown() (err error) {
 	if err != nil {
 		return
 	}
-	if e.retryShutdown < gracefulShutdownLimits {
+	preserveCache, err := e.shouldPreserveCacheForRecreation()
+	if err != nil {
+		return
+	}
+	if preserveCache {
+		e.Log.Info("skip cleaning up the cache on shutdown, the cache directories are preserved for recreation")
+	} else if e.retryShutdown < gracefulShutdownLimits {
 		err = e.cleanupCache()
 		if err != nil {
 			e.retryShutdown = e.retryShutdown + 1
@@ -111,6 +117,35 @@ func (e *AlluxioEngine) destroyMaster() (err error) {
 // 	return nil
 // }
 
+// shouldPreserveCacheForRecreation checks whether the dataset asks to keep its on-disk
+// cache directories when it's deleted, so that a recreated dataset with the same runtime
+// adopts the warmed cache instead of starting cold. Only disk-backed hostPath tieredstore
+// levels can outlive the runtime pods, so the policy is ignored for pure in-memory caches.
+func (e *AlluxioEngine) shouldPreserveCacheForRecreation() (preserve bool, err error) {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return false, nil
+		}
+		return false, err
+	}
+	if dataset.Annotations[common.AnnotationDatasetRecreateCachePolicy] != common.RecreateCachePolicyReuse {
+		return false, nil
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return false, err
+	}
+	for _, level := range runtime.Spec.TieredStore.Levels {
+		if level.MediumType != common.Memory && level.VolumeType == common.VolumeTypeHostPath {
+			return true, nil
+		}
+	}
+	e.Log.Info("ignore the cache reuse policy, no disk-backed hostPath tieredstore level to preserve")
+	return false, nil
+}
+
 // cleanupCache cleans up the cache
 func (e *AlluxioEngine) cleanupCache() (err error) {
 	// TODO(cheyang): clean up the cache
@@ -284,6 +319,12 @@ func (e *AlluxioEngine) destroyWorkers(expectedWorkers int32) (currentWorkers in
 			continue
 		}
 
+		// On scale-in, mark the worker as draining first so fuse clients stop selecting
+		// it for new reads and its in-flight streams get a bounded period to finish
+		if expectedWorkers >= 0 {
+			e.drainWorkerOnNode(node.Name)
+		}
+
 		nodeName := node.Name
 		var labelsToModify common.LabelsToModify
 		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
@@ -24,6 +24,7 @@ import (
 	data "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	"github.com/fluid-cloudnative/fluid/pkg/defaults"
 	fluiderrs "github.com/fluid-cloudnative/fluid/pkg/errors"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -58,6 +59,12 @@ func (e *AlluxioEngine) CheckAndUpdateRuntimeStatus() (ready bool, err error) {
 		return ready, err
 	}
 
+	// 3. Surface the layered cluster/namespace defaults this runtime inherits
+	effectiveDefaults, err := defaults.LoadEffective(e.Client, e.namespace)
+	if err != nil {
+		return ready, err
+	}
+
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		runtime, err := e.getRuntime()
 		if err != nil {
@@ -120,6 +127,8 @@ func (e *AlluxioEngine) CheckAndUpdateRuntimeStatus() (ready bool, err error) {
 			ready = true
 		}
 
+		runtimeToUpdate.Status.EffectiveDefaults = effectiveDefaults.AsMap()
+
 		// Update the setup time of Alluxio runtime
 		if ready && runtimeToUpdate.Status.SetupDuration == "" {
 			runtimeToUpdate.Status.SetupDuration = utils.CalculateDuration(runtimeToUpdate.CreationTimestamp.Time, time.Now())
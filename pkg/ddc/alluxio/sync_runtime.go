@@ -16,9 +16,33 @@ limitations under the License.
 
 package alluxio
 
-import cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
+	corev1 "k8s.io/api/core/v1"
+)
 
 // SyncRuntime syncs the runtime spec
 func (e *AlluxioEngine) SyncRuntime(ctx cruntime.ReconcileRequestContext) (changed bool, err error) {
+	// transition the volume between read-only and read-write when the dataset's
+	// declared access modes changed after the volume was created
+	runtimeInfo, err := e.getRuntimeInfo()
+	if err != nil {
+		return false, err
+	}
+	updated, err := volume.SyncAccessModes(e.Client, runtimeInfo, e.Log)
+	if err != nil {
+		// a blocked transition is recoverable, surface it and retry in the next sync
+		e.Log.Info("the access mode transition is pending", "reason", err.Error())
+		e.Recorder.Eventf(ctx.Runtime, corev1.EventTypeWarning, common.AccessModeTransitionBlocked, "%v", err)
+		return false, nil
+	}
+	if updated {
+		e.Recorder.Eventf(ctx.Runtime, corev1.EventTypeNormal, common.AccessModeTransitioned,
+			"Transitioned the access modes of the dataset volume")
+		changed = true
+	}
+
 	return
 }
@@ -46,6 +46,17 @@ func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *
 		return value, err
 	}
 
+	// check the free-form properties against the known option groups before any of
+	// them gets rendered into the value file
+	if err = e.validateRuntimeProperties(runtime); err != nil {
+		return value, err
+	}
+
+	// fill the settings the spec leaves unset from the layered cluster/namespace defaults
+	if err = e.applyEffectiveDefaults(runtime); err != nil {
+		return value, err
+	}
+
 	value = &Alluxio{
 		Owner: transfromer.GenerateOwnerReferenceFromObject(runtime),
 	}
@@ -76,11 +87,20 @@ func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *
 		return
 	}
 
-	err = e.transformPodMetadata(runtime, value)
+	err = e.transformPodMetadata(runtime, dataset, value)
 	if err != nil {
 		return
 	}
 
+	err = e.transformUpdateStrategies(runtime, value)
+	if err != nil {
+		return
+	}
+
+	e.transformNetworking(dataset, value)
+
+	e.transformSecondaryNetwork(runtime, value)
+
 	// 5.transform the hadoop non-default configurations
 	err = e.transformHadoopConfig(runtime, value)
 	if err != nil {
@@ -117,12 +137,50 @@ func (e *AlluxioEngine) transform(runtime *datav1alpha1.AlluxioRuntime) (value *
 
 	// 12.set API Gateway
 	err = e.transformAPIGateway(runtime, value)
+	if err != nil {
+		return
+	}
 
-	// 13.set the placementMode
+	// 13.set NFS Gateway
+	err = e.transformNFSGateway(runtime, value)
+	if err != nil {
+		return
+	}
+
+	// 14.set the placementMode
 	e.transformPlacementMode(dataset, value)
 	return
 }
 
+// transformNetworking propagates the dataset's DNS and proxy settings to the runtime
+// components, so the UFS stays reachable behind corporate proxies or split-horizon DNS.
+func (e *AlluxioEngine) transformNetworking(dataset *datav1alpha1.Dataset, value *Alluxio) {
+	networking := dataset.Spec.Networking
+	if networking == nil {
+		return
+	}
+
+	value.DNSConfig = networking.DNSConfig
+	value.HostAliases = networking.HostAliases
+
+	proxyEnv := map[string]string{}
+	if len(networking.HTTPProxy) > 0 {
+		proxyEnv["HTTP_PROXY"] = networking.HTTPProxy
+		proxyEnv["HTTPS_PROXY"] = networking.HTTPProxy
+	}
+	if len(networking.NoProxy) > 0 {
+		proxyEnv["NO_PROXY"] = networking.NoProxy
+	}
+	if len(proxyEnv) == 0 {
+		return
+	}
+
+	// a proxy setting explicitly declared on the runtime wins over the dataset's
+	value.Master.Env = utils.UnionMapsWithOverride(proxyEnv, value.Master.Env)
+	value.Worker.Env = utils.UnionMapsWithOverride(proxyEnv, value.Worker.Env)
+	value.Fuse.Env = utils.UnionMapsWithOverride(proxyEnv, value.Fuse.Env)
+}
+
 // 2. Transform the common part
 func (e *AlluxioEngine) transformCommonPart(runtime *datav1alpha1.AlluxioRuntime,
 	dataset *datav1alpha1.Dataset,
@@ -248,15 +306,19 @@ func (e *AlluxioEngine) transformCommonPart(runtime *datav1alpha1.AlluxioRuntime
 	return
 }
 
-func (e *AlluxioEngine) transformPodMetadata(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) (err error) {
+func (e *AlluxioEngine) transformPodMetadata(runtime *datav1alpha1.AlluxioRuntime, dataset *datav1alpha1.Dataset, value *Alluxio) (err error) {
+	// shared metadata of the dataset is the base layer, runtime-level and then
+	// component-level metadata override it on conflicts
+	sharedLabels, sharedAnnotations := utils.GetSharedMetadataOfDataset(*dataset)
+
 	// transform labels
-	commonLabels := utils.UnionMapsWithOverride(map[string]string{}, runtime.Spec.PodMetadata.Labels)
+	commonLabels := utils.UnionMapsWithOverride(sharedLabels, runtime.Spec.PodMetadata.Labels)
 	value.Master.Labels = utils.UnionMapsWithOverride(commonLabels, runtime.Spec.Master.PodMetadata.Labels)
 	value.Worker.Labels = utils.UnionMapsWithOverride(commonLabels, runtime.Spec.Worker.PodMetadata.Labels)
 	value.Fuse.Labels = utils.UnionMapsWithOverride(commonLabels, runtime.Spec.Fuse.PodMetadata.Labels)
 
 	// transform annotations
-	commonAnnotations := utils.UnionMapsWithOverride(map[string]string{}, runtime.Spec.PodMetadata.Annotations)
+	commonAnnotations := utils.UnionMapsWithOverride(sharedAnnotations, runtime.Spec.PodMetadata.Annotations)
 	value.Master.Annotations = utils.UnionMapsWithOverride(commonAnnotations, runtime.Spec.Master.PodMetadata.Annotations)
 	value.Worker.Annotations = utils.UnionMapsWithOverride(commonAnnotations, runtime.Spec.Worker.PodMetadata.Annotations)
 	value.Fuse.Annotations = utils.UnionMapsWithOverride(commonAnnotations, runtime.Spec.Fuse.PodMetadata.Annotations)
@@ -264,6 +326,39 @@ func (e *AlluxioEngine) transformPodMetadata(runtime *datav1alpha1.AlluxioRuntim
 	return nil
 }
 
+// transformSecondaryNetwork attaches master, worker and fuse pods to the configured
+// secondary network through the Multus networks annotation. When a data interface is
+// given, each attachment reference is suffixed with it so Multus names the in-pod
+// interface deterministically, and the engine's transports listen on all interfaces so
+// that data requests arriving from the secondary network are served on it while kube
+// traffic stays on the primary one.
+func (e *AlluxioEngine) transformSecondaryNetwork(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) {
+	secondaryNetwork := runtime.Spec.SecondaryNetwork
+	if secondaryNetwork == nil {
+		return
+	}
+
+	references := make([]string, 0, len(secondaryNetwork.NetworkAttachmentDefinitions))
+	for _, reference := range secondaryNetwork.NetworkAttachmentDefinitions {
+		if secondaryNetwork.DataInterface != "" {
+			reference = fmt.Sprintf("%s@%s", reference, secondaryNetwork.DataInterface)
+		}
+		references = append(references, reference)
+	}
+	networksAnnotation := map[string]string{common.AnnotationMultusNetworks: strings.Join(references, ",")}
+
+	value.Master.Annotations = utils.UnionMapsWithOverride(value.Master.Annotations, networksAnnotation)
+	value.Worker.Annotations = utils.UnionMapsWithOverride(value.Worker.Annotations, networksAnnotation)
+	value.Fuse.Annotations = utils.UnionMapsWithOverride(value.Fuse.Annotations, networksAnnotation)
+
+	if secondaryNetwork.DataInterface != "" {
+		value.Properties = utils.UnionMapsWithOverride(value.Properties, map[string]string{
+			"alluxio.master.bind.host": "0.0.0.0",
+			"alluxio.worker.bind.host": "0.0.0.0",
+		})
+	}
+}
+
 // 2. Transform the masters
 func (e *AlluxioEngine) transformMasters(runtime *datav1alpha1.AlluxioRuntime,
 	dataset *datav1alpha1.Dataset,
@@ -369,6 +464,11 @@ func (e *AlluxioEngine) transformWorkers(runtime *datav1alpha1.AlluxioRuntime, v
 		value.Worker.NodeSelector = map[string]string{}
 	}
 
+	// only schedule workers onto nodes that actually have the declared tieredstore media
+	if err = e.transformMediaNodeSelector(runtime, value); err != nil {
+		return err
+	}
+
 	if len(runtime.Spec.Worker.Properties) > 0 {
 		value.Worker.Properties = runtime.Spec.Worker.Properties
 		runtime.Spec.Properties = utils.UnionMapsWithOverride(runtime.Spec.Properties, runtime.Spec.Worker.Properties)
@@ -93,6 +93,8 @@ func (e *AlluxioEngine) transformFuse(runtime *datav1alpha1.AlluxioRuntime, data
 		value.Fuse.Args[1] = strings.Join([]string{value.Fuse.Args[1], "allow_other"}, ",")
 	}
 
+	e.transformFileDefaults(dataset, value)
+
 	if len(runtime.Spec.Fuse.NodeSelector) > 0 {
 		value.Fuse.NodeSelector = runtime.Spec.Fuse.NodeSelector
 	} else {
@@ -120,6 +122,29 @@ func (e *AlluxioEngine) transformFuse(runtime *datav1alpha1.AlluxioRuntime, data
 
 }
 
+// transformFileDefaults applies the dataset's declared default permissions for newly
+// created files. Alluxio derives both file and directory modes from the creation umask,
+// which is enforced on the masters and workers as well as passed to the fuse mount.
+func (e *AlluxioEngine) transformFileDefaults(dataset *datav1alpha1.Dataset, value *Alluxio) {
+	if dataset.Spec.FileDefaults == nil {
+		return
+	}
+	umask := dataset.Spec.FileDefaults.EffectiveUmask()
+	if umask == "" {
+		return
+	}
+
+	if len(value.Properties) == 0 {
+		value.Properties = map[string]string{}
+	}
+	if _, found := value.Properties["alluxio.security.authorization.permission.umask"]; !found {
+		value.Properties["alluxio.security.authorization.permission.umask"] = umask
+	}
+	if len(value.Fuse.Args) > 1 && !strings.Contains(value.Fuse.Args[1], "umask=") {
+		value.Fuse.Args[1] = strings.Join([]string{value.Fuse.Args[1], fmt.Sprintf("umask=%s", umask)}, ",")
+	}
+}
+
 func checkIfNewFuseArgVersion(version string) (newFuseVersion bool, err error) {
 	compare, err := versionutil.Compare(version, newFuseArgsVersion)
 	if err != nil {
@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MediaSchedulingEnv enables scheduling workers only onto nodes labeled with the
+// tieredstore's storage media by the node storage discovery.
+const MediaSchedulingEnv = "NODE_STORAGE_MEDIA_SCHEDULING"
+
+// transformMediaNodeSelector restricts the worker node selector to nodes that actually
+// provide the storage media declared in the tieredstore, relying on the media labels
+// published by the node storage discovery. It fails with a clear message when no node
+// in the cluster qualifies.
+func (e *AlluxioEngine) transformMediaNodeSelector(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) (err error) {
+	if !utils.GetBoolValueFormEnv(MediaSchedulingEnv, false) {
+		return nil
+	}
+
+	mediaSelector := map[string]string{}
+	for _, level := range runtime.Spec.TieredStore.Levels {
+		// every node has memory, only disk-backed media need discovery
+		if level.MediumType == common.Memory || level.MediumType == "" {
+			continue
+		}
+		labelKey := common.LabelAnnotationStorageMediaPrefix + strings.ToLower(string(level.MediumType))
+		mediaSelector[labelKey] = "true"
+	}
+	if len(mediaSelector) == 0 {
+		return nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err = e.List(context.TODO(), nodeList, client.MatchingLabels(mediaSelector)); err != nil {
+		return err
+	}
+	if len(nodeList.Items) == 0 {
+		return fmt.Errorf("no node provides the storage media required by the tieredstore, "+
+			"expect nodes labeled with %v by the node storage discovery", mediaSelector)
+	}
+
+	for labelKey, labelValue := range mediaSelector {
+		value.Worker.NodeSelector[labelKey] = labelValue
+	}
+	e.Log.Info("Restrict workers to nodes with the declared storage media",
+		"mediaSelector", mediaSelector, "candidates", len(nodeList.Items))
+	return nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// transformNFSGateway decides whether to enable the NFS gateway in value according to
+// AlluxioRuntime. The gateway is a managed Deployment plus Service that re-exports the
+// dataset's fuse mount read-only over NFSv4 for clients outside Kubernetes.
+func (e *AlluxioEngine) transformNFSGateway(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) (err error) {
+	if runtime == nil || value == nil {
+		err = fmt.Errorf("cannot transform because runtime or value will lead to nil pointer")
+		return
+	}
+	value.NFSGateway.Enabled = runtime.Spec.NFSGateway.Enabled
+	if !runtime.Spec.NFSGateway.Enabled {
+		return
+	}
+
+	value.NFSGateway.Replicas = runtime.Spec.NFSGateway.Replicas
+	if value.NFSGateway.Replicas == 0 {
+		value.NFSGateway.Replicas = 1
+	}
+	value.NFSGateway.NodeSelector = runtime.Spec.NFSGateway.NodeSelector
+	if len(runtime.Spec.NFSGateway.Resources.Limits) > 0 || len(runtime.Spec.NFSGateway.Resources.Requests) > 0 {
+		value.NFSGateway.Resources = utils.TransformRequirementsToResources(runtime.Spec.NFSGateway.Resources)
+	}
+	if port, found := runtime.Spec.NFSGateway.Ports["nfs"]; found {
+		value.NFSGateway.Ports.Nfs = port
+	}
+	return
+}
@@ -125,9 +125,47 @@ func (e *AlluxioEngine) transformResourcesForWorker(runtime *datav1alpha1.Alluxi
 		}
 	}
 
+	if runtime.Spec.Worker.CPUPolicy == datav1alpha1.CPUPolicyPinned {
+		pinnedResources, err := applyPinnedCPUPolicy("worker", value.Worker.Resources, runtime.Spec.Worker.Resources)
+		if err != nil {
+			e.Log.Error(err, "failed to apply pinned cpu policy for worker")
+			return err
+		}
+		value.Worker.Resources = pinnedResources
+	}
+
 	return nil
 }
 
+// applyPinnedCPUPolicy raises the component's requests to its limits so that the pod
+// gets guaranteed QoS: with whole CPUs, kubelet's static CPU manager pins the component
+// to exclusive cores and the memory manager provides NUMA-aligned topology hints.
+func applyPinnedCPUPolicy(role string, resources common.Resources, spec corev1.ResourceRequirements) (common.Resources, error) {
+	cpuLimit, found := spec.Limits[corev1.ResourceCPU]
+	if !found || cpuLimit.IsZero() {
+		return resources, fmt.Errorf("cpuPolicy pinned for %s requires a cpu limit", role)
+	}
+	if cpuLimit.MilliValue()%1000 != 0 {
+		return resources, fmt.Errorf("cpuPolicy pinned for %s requires a whole number of cpus, got %s", role, cpuLimit.String())
+	}
+	memLimit, found := spec.Limits[corev1.ResourceMemory]
+	if !found || memLimit.IsZero() {
+		return resources, fmt.Errorf("cpuPolicy pinned for %s requires a memory limit", role)
+	}
+
+	if resources.Requests == nil {
+		resources.Requests = make(common.ResourceList)
+	}
+	if resources.Limits == nil {
+		resources.Limits = make(common.ResourceList)
+	}
+	resources.Limits[corev1.ResourceCPU] = cpuLimit.String()
+	resources.Limits[corev1.ResourceMemory] = memLimit.String()
+	resources.Requests[corev1.ResourceCPU] = cpuLimit.String()
+	resources.Requests[corev1.ResourceMemory] = memLimit.String()
+	return resources, nil
+}
+
 func (e *AlluxioEngine) transformResourcesForFuse(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) {
 
 	if runtime.Spec.Fuse.Resources.Limits == nil {
@@ -178,6 +216,18 @@ func (e *AlluxioEngine) transformResourcesForFuse(runtime *datav1alpha1.AlluxioR
 		value.Fuse.Resources.Limits[corev1.ResourceMemory] = memLimit.String()
 	}
 
+	if runtime.Spec.Fuse.CPUPolicy == datav1alpha1.CPUPolicyPinned {
+		pinnedResources, err := applyPinnedCPUPolicy("fuse", value.Fuse.Resources, runtime.Spec.Fuse.Resources)
+		if err != nil {
+			e.Log.Error(err, "failed to apply pinned cpu policy for fuse, skip pinning")
+			return
+		}
+		// keep the memory limit adjusted for the memory tiered store, and align the
+		// request with it for guaranteed QoS
+		pinnedResources.Limits[corev1.ResourceMemory] = memLimit.String()
+		pinnedResources.Requests[corev1.ResourceMemory] = memLimit.String()
+		value.Fuse.Resources = pinnedResources
+	}
 }
 
 func (e *AlluxioEngine) transformTolerations(dataset *datav1alpha1.Dataset, value *Alluxio) {
@@ -26,6 +26,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/net"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -397,6 +398,7 @@ func TestTransformPodMetadata(t *testing.T) {
 	type testCase struct {
 		Name    string
 		Runtime *datav1alpha1.AlluxioRuntime
+		Dataset *datav1alpha1.Dataset
 		Value   *Alluxio
 
 		wantValue *Alluxio
@@ -413,7 +415,8 @@ func TestTransformPodMetadata(t *testing.T) {
 					},
 				},
 			},
-			Value: &Alluxio{},
+			Dataset: &datav1alpha1.Dataset{},
+			Value:   &Alluxio{},
 			wantValue: &Alluxio{
 				Master: Master{
 					Labels:      map[string]string{"common-key": "common-value"},
@@ -451,7 +454,8 @@ func TestTransformPodMetadata(t *testing.T) {
 					},
 				},
 			},
-			Value: &Alluxio{},
+			Dataset: &datav1alpha1.Dataset{},
+			Value:   &Alluxio{},
 			wantValue: &Alluxio{
 				Master: Master{
 					Labels:      map[string]string{"common-key": "master-value"},
@@ -468,8 +472,44 @@ func TestTransformPodMetadata(t *testing.T) {
 		},
 	}
 
+	testCases = append(testCases, testCase{
+		Name: "propagate_dataset_shared_metadata_without_override",
+		Runtime: &datav1alpha1.AlluxioRuntime{
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				PodMetadata: datav1alpha1.PodMetadata{
+					Labels: map[string]string{"common-key": "common-value"},
+				},
+			},
+		},
+		Dataset: &datav1alpha1.Dataset{
+			Spec: datav1alpha1.DatasetSpec{
+				SharedLabels: map[string]string{
+					"common-key":          "shared-value",
+					"team":                "data-infra",
+					"fluid.io/s-reserved": "dropped",
+				},
+				SharedAnnotations: map[string]string{"cost-center": "1024"},
+			},
+		},
+		Value: &Alluxio{},
+		wantValue: &Alluxio{
+			Master: Master{
+				Labels:      map[string]string{"common-key": "common-value", "team": "data-infra"},
+				Annotations: map[string]string{"cost-center": "1024"},
+			},
+			Worker: Worker{
+				Labels:      map[string]string{"common-key": "common-value", "team": "data-infra"},
+				Annotations: map[string]string{"cost-center": "1024"},
+			},
+			Fuse: Fuse{
+				Labels:      map[string]string{"common-key": "common-value", "team": "data-infra"},
+				Annotations: map[string]string{"cost-center": "1024"},
+			},
+		},
+	})
+
 	for _, tt := range testCases {
-		err := engine.transformPodMetadata(tt.Runtime, tt.Value)
+		err := engine.transformPodMetadata(tt.Runtime, tt.Dataset, tt.Value)
 		if err != nil {
 			t.Fatalf("test name: %s. Expect err = nil, but got err = %v", tt.Name, err)
 		}
@@ -1032,3 +1072,47 @@ func TestTransformFuseProperties(t *testing.T) {
 		}
 	}
 }
+
+func TestTransformNetworking(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.DatasetSpec{
+			Networking: &datav1alpha1.Networking{
+				DNSConfig: &corev1.PodDNSConfig{
+					Nameservers: []string{"10.0.0.53"},
+				},
+				HTTPProxy: "http://proxy.corp:3128",
+				NoProxy:   "10.0.0.0/8",
+				HostAliases: []corev1.HostAlias{
+					{IP: "10.0.0.7", Hostnames: []string{"oss.internal"}},
+				},
+			},
+		},
+	}
+
+	value := &Alluxio{
+		Fuse: Fuse{
+			// an explicit runtime setting must win over the dataset's
+			Env: map[string]string{"HTTP_PROXY": "http://other-proxy:3128"},
+		},
+	}
+	engine := &AlluxioEngine{Log: fake.NullLogger()}
+	engine.transformNetworking(dataset, value)
+
+	if value.DNSConfig == nil || len(value.DNSConfig.Nameservers) != 1 {
+		t.Errorf("expect the dnsConfig to propagate, got %v", value.DNSConfig)
+	}
+	if len(value.HostAliases) != 1 || value.HostAliases[0].IP != "10.0.0.7" {
+		t.Errorf("expect the hostAliases to propagate, got %v", value.HostAliases)
+	}
+	if value.Master.Env["HTTPS_PROXY"] != "http://proxy.corp:3128" ||
+		value.Worker.Env["NO_PROXY"] != "10.0.0.0/8" {
+		t.Errorf("expect the proxy env on master and worker, got %v and %v", value.Master.Env, value.Worker.Env)
+	}
+	if value.Fuse.Env["HTTP_PROXY"] != "http://other-proxy:3128" {
+		t.Errorf("expect the runtime's own proxy env to win, got %v", value.Fuse.Env)
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// transformUpdateStrategies validates the per-component update strategies declared on the
+// runtime and passes them to the generated workloads, leaving the chart defaults in place
+// for components without an override
+func (e *AlluxioEngine) transformUpdateStrategies(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) (err error) {
+	if value.Master.UpdateStrategy, err = transformComponentUpdateStrategy(runtime.Spec.Master.UpdateStrategy, "master"); err != nil {
+		return err
+	}
+	if value.Worker.UpdateStrategy, err = transformComponentUpdateStrategy(runtime.Spec.Worker.UpdateStrategy, "worker"); err != nil {
+		return err
+	}
+	if value.Fuse.UpdateStrategy, err = transformComponentUpdateStrategy(runtime.Spec.Fuse.UpdateStrategy, "fuse"); err != nil {
+		return err
+	}
+	return nil
+}
+
+// transformComponentUpdateStrategy validates a single component's update strategy against
+// the kind of workload generated for it: master and worker become StatefulSets which only
+// honor a rolling update partition, the fuse becomes a DaemonSet which only honors
+// maxUnavailable. An unset type is inferred as RollingUpdate when a rolling update
+// parameter is given.
+func transformComponentUpdateStrategy(strategy *datav1alpha1.ComponentUpdateStrategy, component string) (*UpdateStrategy, error) {
+	if strategy == nil {
+		return nil, nil
+	}
+
+	isFuse := component == "fuse"
+	if strategy.MaxUnavailable != nil && !isFuse {
+		return nil, fmt.Errorf("updateStrategy.maxUnavailable is only supported for the fuse, not for the %s StatefulSet, use partition instead", component)
+	}
+	if strategy.Partition != nil && isFuse {
+		return nil, fmt.Errorf("updateStrategy.partition is only supported for master and worker, not for the fuse DaemonSet, use maxUnavailable instead")
+	}
+
+	strategyType := strategy.Type
+	hasRollingUpdateParams := strategy.MaxUnavailable != nil || strategy.Partition != nil
+	if strategyType == string(appsv1.OnDeleteStatefulSetStrategyType) && hasRollingUpdateParams {
+		return nil, fmt.Errorf("updateStrategy of the %s sets rolling update parameters but its type is OnDelete", component)
+	}
+	if strategyType == "" {
+		if !hasRollingUpdateParams {
+			// nothing to override, keep the chart default
+			return nil, nil
+		}
+		strategyType = string(appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+
+	return &UpdateStrategy{
+		Type:           strategyType,
+		MaxUnavailable: strategy.MaxUnavailable,
+		Partition:      strategy.Partition,
+	}, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestTransformComponentUpdateStrategy(t *testing.T) {
+	partition := int32(2)
+	maxUnavailable := intstr.FromString("10%")
+
+	tests := []struct {
+		name      string
+		strategy  *datav1alpha1.ComponentUpdateStrategy
+		component string
+		want      *UpdateStrategy
+		wantErr   bool
+	}{
+		{
+			name:      "no_override",
+			strategy:  nil,
+			component: "worker",
+			want:      nil,
+		},
+		{
+			name:      "empty_override_keeps_chart_default",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{},
+			component: "master",
+			want:      nil,
+		},
+		{
+			name:      "worker_partition_infers_rolling_update",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{Partition: &partition},
+			component: "worker",
+			want:      &UpdateStrategy{Type: "RollingUpdate", Partition: &partition},
+		},
+		{
+			name:      "fuse_rolling_update_with_max_unavailable",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{Type: "RollingUpdate", MaxUnavailable: &maxUnavailable},
+			component: "fuse",
+			want:      &UpdateStrategy{Type: "RollingUpdate", MaxUnavailable: &maxUnavailable},
+		},
+		{
+			name:      "master_on_delete",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{Type: "OnDelete"},
+			component: "master",
+			want:      &UpdateStrategy{Type: "OnDelete"},
+		},
+		{
+			name:      "max_unavailable_rejected_for_statefulset",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{MaxUnavailable: &maxUnavailable},
+			component: "worker",
+			wantErr:   true,
+		},
+		{
+			name:      "partition_rejected_for_daemonset",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{Partition: &partition},
+			component: "fuse",
+			wantErr:   true,
+		},
+		{
+			name:      "rolling_update_params_rejected_with_on_delete",
+			strategy:  &datav1alpha1.ComponentUpdateStrategy{Type: "OnDelete", Partition: &partition},
+			component: "master",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := transformComponentUpdateStrategy(tt.strategy, tt.component)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("transformComponentUpdateStrategy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("transformComponentUpdateStrategy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransformUpdateStrategies(t *testing.T) {
+	partition := int32(1)
+	runtime := &datav1alpha1.AlluxioRuntime{
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			Worker: datav1alpha1.AlluxioCompTemplateSpec{
+				UpdateStrategy: &datav1alpha1.ComponentUpdateStrategy{Partition: &partition},
+			},
+			Fuse: datav1alpha1.AlluxioFuseSpec{
+				UpdateStrategy: &datav1alpha1.ComponentUpdateStrategy{Type: "OnDelete"},
+			},
+		},
+	}
+	engine := &AlluxioEngine{}
+	value := &Alluxio{}
+	if err := engine.transformUpdateStrategies(runtime, value); err != nil {
+		t.Errorf("fail to transform the update strategies with error %v", err)
+	}
+	if value.Master.UpdateStrategy != nil {
+		t.Errorf("expect no update strategy override for the master, got %v", value.Master.UpdateStrategy)
+	}
+	if value.Worker.UpdateStrategy == nil || value.Worker.UpdateStrategy.Type != "RollingUpdate" {
+		t.Errorf("expect a RollingUpdate override for the worker, got %v", value.Worker.UpdateStrategy)
+	}
+	if value.Fuse.UpdateStrategy == nil || value.Fuse.UpdateStrategy.Type != "OnDelete" {
+		t.Errorf("expect an OnDelete override for the fuse, got %v", value.Fuse.UpdateStrategy)
+	}
+}
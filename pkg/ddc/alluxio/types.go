@@ -20,6 +20,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 )
@@ -34,6 +35,9 @@ type Alluxio struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 	JvmOptions   []string          `json:"jvmOptions,omitempty"`
 
+	DNSConfig   *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+	HostAliases []corev1.HostAlias   `json:"hostAliases,omitempty"`
+
 	Properties map[string]string `json:"properties,omitempty"`
 
 	Master Master `json:"master,omitempty"`
@@ -48,6 +52,8 @@ type Alluxio struct {
 
 	APIGateway APIGateway `json:"apiGateway,omitempty"`
 
+	NFSGateway NFSGateway `json:"nfsGateway,omitempty"`
+
 	TieredStore TieredStore `json:"tieredstore,omitempty"`
 
 	Metastore Metastore `json:"metastore,omitempty"`
@@ -121,6 +127,18 @@ type APIGateway struct {
 	Ports   Ports `json:"ports,omitempty"`
 }
 
+type NFSGateway struct {
+	Enabled      bool              `json:"enabled,omitempty"`
+	Replicas     int32             `json:"replicas,omitempty"`
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	Resources    common.Resources  `json:"resources,omitempty"`
+	Ports        NFSGatewayPorts   `json:"ports,omitempty"`
+}
+
+type NFSGatewayPorts struct {
+	Nfs int `json:"nfs,omitempty"`
+}
+
 type JobMaster struct {
 	Ports     Ports            `json:"ports,omitempty"`
 	Resources common.Resources `json:"resources,omitempty"`
@@ -132,35 +150,45 @@ type JobWorker struct {
 }
 
 type Worker struct {
-	JvmOptions   []string             `json:"jvmOptions,omitempty"`
-	Env          map[string]string    `json:"env,omitempty"`
-	NodeSelector map[string]string    `json:"nodeSelector,omitempty"`
-	Properties   map[string]string    `json:"properties,omitempty"`
-	HostNetwork  bool                 `json:"hostNetwork,omitempty"`
-	Resources    common.Resources     `json:"resources,omitempty"`
-	Ports        Ports                `json:"ports,omitempty"`
-	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
-	Volumes      []corev1.Volume      `json:"volumes,omitempty"`
-	Labels       map[string]string    `json:"labels,omitempty"`
-	Annotations  map[string]string    `json:"annotations,omitempty"`
+	JvmOptions     []string             `json:"jvmOptions,omitempty"`
+	Env            map[string]string    `json:"env,omitempty"`
+	NodeSelector   map[string]string    `json:"nodeSelector,omitempty"`
+	Properties     map[string]string    `json:"properties,omitempty"`
+	HostNetwork    bool                 `json:"hostNetwork,omitempty"`
+	Resources      common.Resources     `json:"resources,omitempty"`
+	Ports          Ports                `json:"ports,omitempty"`
+	VolumeMounts   []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	Volumes        []corev1.Volume      `json:"volumes,omitempty"`
+	Labels         map[string]string    `json:"labels,omitempty"`
+	Annotations    map[string]string    `json:"annotations,omitempty"`
+	UpdateStrategy *UpdateStrategy      `json:"updateStrategy,omitempty"`
 }
 
 type Master struct {
-	JvmOptions   []string             `json:"jvmOptions,omitempty"`
-	Env          map[string]string    `json:"env,omitempty"`
-	Affinity     Affinity             `json:"affinity"`
-	NodeSelector map[string]string    `json:"nodeSelector,omitempty"`
-	Properties   map[string]string    `json:"properties,omitempty"`
-	Replicas     int32                `json:"replicaCount,omitempty"`
-	HostNetwork  bool                 `json:"hostNetwork,omitempty"`
-	Resources    common.Resources     `json:"resources,omitempty"`
-	Ports        Ports                `json:"ports,omitempty"`
-	BackupPath   string               `json:"backupPath,omitempty"`
-	Restore      Restore              `json:"restore,omitempty"`
-	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
-	Volumes      []corev1.Volume      `json:"volumes,omitempty"`
-	Labels       map[string]string    `json:"labels,omitempty"`
-	Annotations  map[string]string    `json:"annotations,omitempty"`
+	JvmOptions     []string             `json:"jvmOptions,omitempty"`
+	Env            map[string]string    `json:"env,omitempty"`
+	Affinity       Affinity             `json:"affinity"`
+	NodeSelector   map[string]string    `json:"nodeSelector,omitempty"`
+	Properties     map[string]string    `json:"properties,omitempty"`
+	Replicas       int32                `json:"replicaCount,omitempty"`
+	HostNetwork    bool                 `json:"hostNetwork,omitempty"`
+	Resources      common.Resources     `json:"resources,omitempty"`
+	Ports          Ports                `json:"ports,omitempty"`
+	BackupPath     string               `json:"backupPath,omitempty"`
+	Restore        Restore              `json:"restore,omitempty"`
+	VolumeMounts   []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	Volumes        []corev1.Volume      `json:"volumes,omitempty"`
+	Labels         map[string]string    `json:"labels,omitempty"`
+	Annotations    map[string]string    `json:"annotations,omitempty"`
+	UpdateStrategy *UpdateStrategy      `json:"updateStrategy,omitempty"`
+}
+
+// UpdateStrategy is the workload update strategy override of a component, rendered
+// into the generated StatefulSet or DaemonSet
+type UpdateStrategy struct {
+	Type           string              `json:"type,omitempty"`
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	Partition      *int32              `json:"partition,omitempty"`
 }
 
 type Restore struct {
@@ -189,6 +217,7 @@ type Fuse struct {
 	Volumes            []corev1.Volume      `json:"volumes,omitempty"`
 	Labels             map[string]string    `json:"labels,omitempty"`
 	Annotations        map[string]string    `json:"annotations,omitempty"`
+	UpdateStrategy     *UpdateStrategy      `json:"updateStrategy,omitempty"`
 }
 
 type TieredStore struct {
@@ -19,6 +19,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -308,6 +310,41 @@ func (e *AlluxioEngine) genUFSMountOptions(m datav1alpha1.Mount, SharedOptions m
 		return mOptions, err
 	}
 
+	// pin the mount to a point in time of a versioned object store
+	mOptions, err = e.genVersionAtOptions(m, mOptions)
+	if err != nil {
+		return mOptions, err
+	}
+
+	return mOptions, nil
+}
+
+// genVersionAtOptions translates the mount's versionAt pin into UFS properties, so the
+// object store connector resolves object versions as of that timestamp during metadata
+// sync. Only versioned object stores are supported.
+func (e *AlluxioEngine) genVersionAtOptions(m datav1alpha1.Mount, mOptions map[string]string) (map[string]string, error) {
+	if len(m.VersionAt) == 0 {
+		return mOptions, nil
+	}
+
+	versionAt, err := time.Parse(time.RFC3339, m.VersionAt)
+	if err != nil {
+		return mOptions, fmt.Errorf("the versionAt of mount %s is not a RFC3339 timestamp: %v", m.Name, err)
+	}
+
+	supported := false
+	for _, scheme := range []string{"s3://", "s3a://", "s3n://", "oss://"} {
+		if strings.HasPrefix(m.MountPoint, scheme) {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return mOptions, fmt.Errorf("the versionAt of mount %s is only supported for versioned object stores (s3, oss), got mount point %s", m.Name, m.MountPoint)
+	}
+
+	mOptions["alluxio.underfs.version.at"] = versionAt.UTC().Format(time.RFC3339)
+	mOptions["alluxio.underfs.version.at.epoch.ms"] = strconv.FormatInt(versionAt.UnixMilli(), 10)
 	return mOptions, nil
 }
 
@@ -889,3 +889,40 @@ func TestCheckIfRemountRequired(t *testing.T) {
 		})
 	}
 }
+
+func TestGenUFSMountOptionsWithVersionAt(t *testing.T) {
+	engine := &AlluxioEngine{
+		name:      "hbase",
+		namespace: "fluid",
+		Log:       fake.NullLogger(),
+	}
+
+	mount := datav1alpha1.Mount{
+		Name:       "pinned",
+		MountPoint: "s3://bucket/path",
+		VersionAt:  "2023-09-01T00:00:00Z",
+	}
+	options, err := engine.genUFSMountOptions(mount, map[string]string{}, nil)
+	if err != nil {
+		t.Fatalf("AlluxioEngine.genUFSMountOptions() error = %v", err)
+	}
+	if options["alluxio.underfs.version.at"] != "2023-09-01T00:00:00Z" {
+		t.Errorf("expect the version pin to be propagated, got %v", options)
+	}
+	if len(options["alluxio.underfs.version.at.epoch.ms"]) == 0 {
+		t.Errorf("expect the epoch version pin to be propagated, got %v", options)
+	}
+
+	// an invalid timestamp must be rejected
+	mount.VersionAt = "last month"
+	if _, err = engine.genUFSMountOptions(mount, map[string]string{}, nil); err == nil {
+		t.Errorf("expect an error for a non RFC3339 versionAt")
+	}
+
+	// a non-versioned mount point must be rejected
+	mount.VersionAt = "2023-09-01T00:00:00Z"
+	mount.MountPoint = "https://mirrors.bit.edu.cn/apache/spark/"
+	if _, err = engine.genUFSMountOptions(mount, map[string]string{}, nil); err == nil {
+		t.Errorf("expect an error for a mount point without object versioning")
+	}
+}
@@ -221,6 +221,8 @@ func (e *AlluxioEngine) parseRuntimeImage(image string, tag string, imagePullPol
 		imagePullSecrets = docker.GetImagePullSecretsFromEnv(common.EnvImagePullSecretsKey)
 	}
 
+	image, tag = docker.RewriteImageRepoTag(image, tag)
+
 	return image, tag, imagePullPolicy, imagePullSecrets
 }
 
@@ -253,6 +255,8 @@ func (e *AlluxioEngine) parseFuseImage(image string, tag string, imagePullPolicy
 		}
 	}
 
+	image, tag = docker.RewriteImageRepoTag(image, tag)
+
 	return image, tag, imagePullPolicy
 }
 
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// knownAlluxioPropertyPrefixes are the option groups accepted as alluxio properties.
+// Properties outside these groups are almost always typos and are rejected unless the
+// runtime opts out with the skip-property-validation annotation.
+var knownAlluxioPropertyPrefixes = []string{
+	"alluxio.conf.",
+	"alluxio.debug",
+	"alluxio.fuse.",
+	"alluxio.home",
+	"alluxio.integration.",
+	"alluxio.job.",
+	"alluxio.locality.",
+	"alluxio.logger.",
+	"alluxio.master.",
+	"alluxio.metrics.",
+	"alluxio.network.",
+	"alluxio.proxy.",
+	"alluxio.security.",
+	"alluxio.site.",
+	"alluxio.standby.",
+	"alluxio.table.",
+	"alluxio.underfs.",
+	"alluxio.user.",
+	"alluxio.web.",
+	"alluxio.worker.",
+	"alluxio.zookeeper.",
+}
+
+// deprecatedAlluxioProperties maps deprecated property keys to their replacement, or to
+// an empty string when the property has been removed without replacement.
+var deprecatedAlluxioProperties = map[string]string{
+	"alluxio.user.network.netty.timeout.ms":               "alluxio.user.streaming.data.timeout",
+	"alluxio.user.network.netty.writer.packet.size.bytes": "alluxio.user.streaming.writer.chunk.size.bytes",
+	"alluxio.user.file.cache.partially.read.block":        "",
+	"alluxio.keyvalue.enabled":                            "",
+	"alluxio.master.startup.consistency.check.enabled":    "",
+	"alluxio.worker.tieredstore.reserver.interval.ms":     "alluxio.worker.management.tier.align.range",
+	"alluxio.user.file.seek.buffer.size.bytes":            "",
+	"alluxio.user.hostname.resolution.timeout":            "alluxio.network.host.resolution.timeout",
+	"alluxio.worker.memory.size":                          "alluxio.worker.ramdisk.size",
+	"alluxio.master.mount.table.root.readonly":            "alluxio.master.mount.table.root.option",
+}
+
+// validateRuntimeProperties checks the free-form properties of the runtime and of its
+// components against the known alluxio option groups. Unknown keys fail the transform
+// with all offenders listed; deprecated keys only produce a warning event so existing
+// runtimes keep working. The check can be skipped entirely with the
+// common.AnnotationSkipPropertyValidation annotation on the runtime.
+func (e *AlluxioEngine) validateRuntimeProperties(runtime *datav1alpha1.AlluxioRuntime) (err error) {
+	if runtime.Annotations[common.AnnotationSkipPropertyValidation] == "true" {
+		e.Log.Info("skip property validation as requested by annotation")
+		return nil
+	}
+
+	propertiesByComponent := map[string]map[string]string{
+		"spec.properties":        runtime.Spec.Properties,
+		"spec.master.properties": runtime.Spec.Master.Properties,
+		"spec.worker.properties": runtime.Spec.Worker.Properties,
+		"spec.fuse.properties":   runtime.Spec.Fuse.Properties,
+	}
+
+	var unknownKeys []string
+	for component, properties := range propertiesByComponent {
+		for key := range properties {
+			if replacement, deprecated := deprecatedAlluxioProperties[key]; deprecated {
+				message := fmt.Sprintf("Property %q in %s is deprecated", key, component)
+				if replacement != "" {
+					message = fmt.Sprintf("%s, use %q instead", message, replacement)
+				}
+				e.Log.Info(message)
+				e.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.RuntimePropertyDeprecated, message)
+				continue
+			}
+			if !isKnownAlluxioProperty(key) {
+				unknownKeys = append(unknownKeys, fmt.Sprintf("%s/%s", component, key))
+			}
+		}
+	}
+
+	if len(unknownKeys) > 0 {
+		sort.Strings(unknownKeys)
+		return fmt.Errorf("unknown alluxio properties %v, fix the typos or annotate the runtime with %s=true to skip this check",
+			unknownKeys, common.AnnotationSkipPropertyValidation)
+	}
+	return nil
+}
+
+func isKnownAlluxioProperty(key string) bool {
+	for _, prefix := range knownAlluxioPropertyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
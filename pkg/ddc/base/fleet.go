@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// runtimeFleetAttributes extracts the engine image version and scheduled worker replicas
+// from any runtime kind for the fleet inventory metric. Every runtime type names its
+// version field after the engine (alluxioVersion, juicefsVersion, ...), so the spec is
+// scanned generically instead of switching over all runtime kinds.
+func runtimeFleetAttributes(object client.Object) (version string, replicas int64) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return "", 0
+	}
+
+	if spec, ok := content["spec"].(map[string]interface{}); ok {
+		for key, value := range spec {
+			if !strings.HasSuffix(key, "Version") {
+				continue
+			}
+			versionSpec, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			image, _ := versionSpec["image"].(string)
+			imageTag, _ := versionSpec["imageTag"].(string)
+			switch {
+			case len(image) > 0 && len(imageTag) > 0:
+				version = fmt.Sprintf("%s:%s", image, imageTag)
+			case len(imageTag) > 0:
+				version = imageTag
+			default:
+				version = image
+			}
+			break
+		}
+	}
+
+	if status, ok := content["status"].(map[string]interface{}); ok {
+		replicas, _ = status["currentWorkerNumberScheduled"].(int64)
+	}
+
+	return
+}
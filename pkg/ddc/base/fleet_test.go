@@ -0,0 +1,51 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestRuntimeFleetAttributes(t *testing.T) {
+	runtime := &datav1alpha1.AlluxioRuntime{
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			AlluxioVersion: datav1alpha1.VersionSpec{
+				Image:    "alluxio/alluxio",
+				ImageTag: "2.8.0",
+			},
+		},
+		Status: datav1alpha1.RuntimeStatus{
+			CurrentWorkerNumberScheduled: 3,
+		},
+	}
+
+	version, replicas := runtimeFleetAttributes(runtime)
+	if version != "alluxio/alluxio:2.8.0" {
+		t.Errorf("expect the version to be alluxio/alluxio:2.8.0, got %s", version)
+	}
+	if replicas != 3 {
+		t.Errorf("expect 3 scheduled worker replicas, got %d", replicas)
+	}
+
+	// a runtime without a version spec must not panic, just export empty attributes
+	version, replicas = runtimeFleetAttributes(&datav1alpha1.ThinRuntime{})
+	if version != "" || replicas != 0 {
+		t.Errorf("expect empty attributes for an empty runtime, got %s/%d", version, replicas)
+	}
+}
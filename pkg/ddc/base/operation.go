@@ -15,6 +15,7 @@ limitations under the License.
 package base
 
 import (
+	"errors"
 	"fmt"
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -23,6 +24,7 @@ import (
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"time"
@@ -100,13 +102,33 @@ func (t *TemplateEngine) reconcilePending(ctx cruntime.ReconcileRequestContext,
 	opStatus *datav1alpha1.OperationStatus, operation dataoperation.OperationInterface) (ctrl.Result, error) {
 	log := ctx.Log.WithName("reconcilePending")
 
+	// 0. surface the estimated egress cost and gate on the user's acknowledgement when
+	// the operation's spec requires one
+	if waitingErr := checkEgressCostAck(ctx, object, opStatus); waitingErr != nil {
+		if setWaitingCondition(opStatus, waitingErr) {
+			if err := operation.UpdateOperationApiStatus(object, opStatus); err != nil {
+				log.Error(err, fmt.Sprintf("failed to update the waiting condition of the %s", operation.GetOperationType()))
+			}
+		}
+		return utils.RequeueAfterInterval(20 * time.Second)
+	}
+
 	// 1. lock the dataset
 	err := SetDataOperationInTargetDataset(ctx, object, operation, t)
 	if err != nil {
+		// surface the structured waiting reason as a condition instead of only a
+		// generic Pending phase, so users can tell what blocks the operation
+		var waitingErr *WaitingError
+		if errors.As(err, &waitingErr) && setWaitingCondition(opStatus, waitingErr) {
+			if err = operation.UpdateOperationApiStatus(object, opStatus); err != nil {
+				log.Error(err, fmt.Sprintf("failed to update the waiting condition of the %s", operation.GetOperationType()))
+			}
+		}
 		return utils.RequeueAfterInterval(20 * time.Second)
 	}
 
 	log.Info("Get lock on target dataset, try to update phase")
+	clearWaitingCondition(opStatus)
 	opStatus.Phase = common.PhaseExecuting
 	if err = operation.UpdateOperationApiStatus(object, opStatus); err != nil {
 		log.Error(err, fmt.Sprintf("failed to update %s status to Executing, will retry", operation.GetOperationType()))
@@ -196,3 +218,43 @@ func (t *TemplateEngine) reconcileFailed(ctx cruntime.ReconcileRequestContext, o
 	ctx.Recorder.Eventf(object, v1.EventTypeWarning, common.DataOperationFailed, "%s %s failed", operation.GetOperationType(), object.GetName())
 	return utils.NoRequeue()
 }
+
+// setWaitingCondition updates the Waiting condition of the data operation according to the
+// waiting error, returns true if the conditions change and need to be persisted.
+func setWaitingCondition(opStatus *datav1alpha1.OperationStatus, waitingErr *WaitingError) (changed bool) {
+	condition := datav1alpha1.Condition{
+		Type:               common.Waiting,
+		Status:             v1.ConditionTrue,
+		Reason:             waitingErr.Reason,
+		Message:            waitingErr.Error(),
+		LastProbeTime:      metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+	}
+
+	for index, existing := range opStatus.Conditions {
+		if existing.Type != common.Waiting {
+			continue
+		}
+		if existing.Reason == condition.Reason && existing.Message == condition.Message {
+			opStatus.Conditions[index].LastProbeTime = condition.LastProbeTime
+			return false
+		}
+		condition.LastTransitionTime = existing.LastTransitionTime
+		opStatus.Conditions[index] = condition
+		return true
+	}
+
+	opStatus.Conditions = append(opStatus.Conditions, condition)
+	return true
+}
+
+// clearWaitingCondition removes the Waiting condition once the operation is no longer blocked.
+func clearWaitingCondition(opStatus *datav1alpha1.OperationStatus) {
+	conditions := opStatus.Conditions[:0]
+	for _, condition := range opStatus.Conditions {
+		if condition.Type != common.Waiting {
+			conditions = append(conditions, condition)
+		}
+	}
+	opStatus.Conditions = conditions
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	units "github.com/docker/go-units"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EgressPriceTableEnv holds a JSON-encoded EgressPriceTable that overrides the
+	// built-in egress prices of the cost estimation
+	EgressPriceTableEnv = "EGRESS_PRICE_TABLE"
+
+	// defaultEgressPricePerGiB is the fallback egress price in USD per GiB, roughly the
+	// list price of internet egress at the major cloud providers
+	defaultEgressPricePerGiB = 0.09
+
+	// EstimatedEgressCostInfoKey is the status infos key the cost estimate is surfaced under
+	EstimatedEgressCostInfoKey = "EstimatedEgressCost"
+)
+
+// EgressPriceRule prices the egress of transfers whose source and destination URIs match
+// the given prefixes, e.g. from 's3://' to 'oss://'
+type EgressPriceRule struct {
+	// From matches the source URI by prefix
+	From string `json:"from"`
+	// To matches the destination URI by prefix, empty matches any destination including
+	// the cluster-local cache
+	// +optional
+	To string `json:"to,omitempty"`
+	// PricePerGiB is the egress price of matching transfers in USD per GiB, zero marks
+	// the transfer as free (e.g. within the same region)
+	PricePerGiB float64 `json:"pricePerGiB"`
+}
+
+// EgressPriceTable is the configurable price table the egress cost estimation works
+// from, set through the EGRESS_PRICE_TABLE env variable of the controller
+type EgressPriceTable struct {
+	// DefaultPricePerGiB applies to transfers leaving a remote provider that no rule matches
+	DefaultPricePerGiB float64 `json:"defaultPricePerGiB"`
+	// Rules are checked in order, the first rule matching both URIs wins
+	// +optional
+	Rules []EgressPriceRule `json:"rules,omitempty"`
+}
+
+// loadEgressPriceTable reads the price table from the env variable, falling back to the
+// built-in default price when unset or unparsable
+func loadEgressPriceTable(ctx cruntime.ReconcileRequestContext) EgressPriceTable {
+	table := EgressPriceTable{DefaultPricePerGiB: defaultEgressPricePerGiB}
+	if encoded := os.Getenv(EgressPriceTableEnv); encoded != "" {
+		if err := json.Unmarshal([]byte(encoded), &table); err != nil {
+			ctx.Log.Error(err, "fail to parse the egress price table, using the built-in default price", "env", EgressPriceTableEnv)
+			table = EgressPriceTable{DefaultPricePerGiB: defaultEgressPricePerGiB}
+		}
+	}
+	return table
+}
+
+// checkEgressCostAck estimates the egress cost of a DataLoad or DataMigrate before it
+// starts, surfaces the estimate in the operation's status infos and, when the spec sets
+// requireCostAck, holds the operation in Pending until the cost-ack annotation is set.
+// A nil return means the operation may proceed.
+func checkEgressCostAck(ctx cruntime.ReconcileRequestContext, object client.Object,
+	opStatus *datav1alpha1.OperationStatus) *WaitingError {
+	requireAck, fromURI, toURI, estimable := egressEndpoints(ctx, object)
+	if !estimable {
+		return nil
+	}
+
+	pricePerGiB := egressPricePerGiB(loadEgressPriceTable(ctx), fromURI, toURI)
+	if pricePerGiB <= 0 {
+		// the transfer stays within the provider, no egress is billed
+		return nil
+	}
+
+	summary := estimateEgressCost(ctx.Dataset, pricePerGiB)
+	if opStatus.Infos == nil {
+		opStatus.Infos = map[string]string{}
+	}
+	opStatus.Infos[EstimatedEgressCostInfoKey] = summary
+
+	if !requireAck {
+		return nil
+	}
+	if object.GetAnnotations()[common.AnnotationDataOperationCostAck] == "true" {
+		return nil
+	}
+
+	ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationCostAckPending,
+		"Estimated egress cost %s, waiting for annotation %s=\"true\"", summary, common.AnnotationDataOperationCostAck)
+	return &WaitingError{
+		Reason:         common.DataOperationCostAckPending,
+		BlockingObject: GetDataOperationKey(object),
+		message:        fmt.Sprintf("estimated egress cost %s is not acknowledged yet, set the %s annotation to \"true\" to proceed", summary, common.AnnotationDataOperationCostAck),
+	}
+}
+
+// egressEndpoints resolves the source and destination URIs of the data operations whose
+// egress cost can be estimated. An empty destination stands for the cluster-local cache.
+func egressEndpoints(ctx cruntime.ReconcileRequestContext, object client.Object) (requireAck bool, fromURI string, toURI string, estimable bool) {
+	switch op := object.(type) {
+	case *datav1alpha1.DataLoad:
+		return op.Spec.RequireCostAck, datasetMountPoint(ctx.Dataset), "", true
+	case *datav1alpha1.DataMigrate:
+		return op.Spec.RequireCostAck, migrateEndpointURI(ctx, op.Spec.From), migrateEndpointURI(ctx, op.Spec.To), true
+	}
+	return false, "", "", false
+}
+
+// datasetMountPoint returns the dataset's first mount point as its storage URI
+func datasetMountPoint(dataset *datav1alpha1.Dataset) string {
+	if dataset == nil || len(dataset.Spec.Mounts) == 0 {
+		return ""
+	}
+	return dataset.Spec.Mounts[0].MountPoint
+}
+
+// migrateEndpointURI resolves one side of a DataMigrate to its storage URI
+func migrateEndpointURI(ctx cruntime.ReconcileRequestContext, endpoint datav1alpha1.DataToMigrate) string {
+	if endpoint.ExternalStorage != nil {
+		return endpoint.ExternalStorage.URI
+	}
+	if endpoint.DataSet != nil {
+		dataset, err := utils.GetDataset(ctx.Client, endpoint.DataSet.Name, endpoint.DataSet.Namespace)
+		if err != nil {
+			ctx.Log.V(1).Info("fail to get the dataset of the migrate endpoint, treating its egress as unpriced", "dataset", endpoint.DataSet.Name, "error", err)
+			return ""
+		}
+		return datasetMountPoint(dataset)
+	}
+	return ""
+}
+
+// egressPricePerGiB prices a transfer between the two URIs: the first matching rule of
+// the price table wins, otherwise the default price applies whenever data leaves a
+// remote provider for a different one (or for the cluster-local cache)
+func egressPricePerGiB(table EgressPriceTable, fromURI, toURI string) float64 {
+	for _, rule := range table.Rules {
+		if rule.From != "" && strings.HasPrefix(fromURI, rule.From) && (rule.To == "" || strings.HasPrefix(toURI, rule.To)) {
+			return rule.PricePerGiB
+		}
+	}
+
+	// fluid native schemes point at data already inside the cluster, moving it bills no egress
+	if fromURI == "" || common.IsFluidNativeScheme(fromURI) {
+		return 0
+	}
+	// a transfer within the same provider endpoint is assumed free by default, the price
+	// table can say otherwise for cross-region buckets of the same provider
+	if toURI != "" && providerOf(fromURI) == providerOf(toURI) {
+		return 0
+	}
+	return table.DefaultPricePerGiB
+}
+
+// providerOf reduces a storage URI to its scheme and host, the granularity the default
+// pricing reasons at
+func providerOf(uri string) string {
+	rest := uri
+	if index := strings.Index(uri, "://"); index != -1 {
+		rest = uri[index+len("://"):]
+		if slash := strings.Index(rest, "/"); slash != -1 {
+			rest = rest[:slash]
+		}
+		return uri[:index+len("://")] + rest
+	}
+	return uri
+}
+
+// estimateEgressCost renders the estimate from the target dataset's total size, falling
+// back to an explicit unknown when the size is not reported yet
+func estimateEgressCost(dataset *datav1alpha1.Dataset, pricePerGiB float64) string {
+	if dataset != nil && dataset.Status.UfsTotal != "" {
+		if sizeBytes, err := units.RAMInBytes(dataset.Status.UfsTotal); err == nil && sizeBytes >= 0 {
+			cost := float64(sizeBytes) / float64(units.GiB) * pricePerGiB
+			return fmt.Sprintf("$%.2f (%s at $%.3f/GiB)", cost, dataset.Status.UfsTotal, pricePerGiB)
+		}
+	}
+	return fmt.Sprintf("unknown (dataset size not reported yet, $%.3f/GiB)", pricePerGiB)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestEgressPricePerGiB(t *testing.T) {
+	table := EgressPriceTable{
+		DefaultPricePerGiB: 0.09,
+		Rules: []EgressPriceRule{
+			{From: "s3://", To: "oss://", PricePerGiB: 0.12},
+			{From: "s3://archive-bucket", PricePerGiB: 0.02},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		fromURI string
+		toURI   string
+		want    float64
+	}{
+		{
+			name:    "rule_matches_both_sides",
+			fromURI: "s3://bucket/path",
+			toURI:   "oss://bucket/path",
+			want:    0.12,
+		},
+		{
+			name:    "rule_with_empty_to_matches_load_into_cache",
+			fromURI: "s3://archive-bucket/path",
+			toURI:   "",
+			want:    0.02,
+		},
+		{
+			name:    "native_scheme_bills_no_egress",
+			fromURI: "pvc://my-pvc/path",
+			toURI:   "s3://bucket/path",
+			want:    0,
+		},
+		{
+			name:    "same_provider_is_free_by_default",
+			fromURI: "oss://bucket/from",
+			toURI:   "oss://bucket/to",
+			want:    0,
+		},
+		{
+			name:    "unmatched_remote_source_gets_the_default_price",
+			fromURI: "gcs://bucket/path",
+			toURI:   "",
+			want:    0.09,
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := egressPricePerGiB(table, testCase.fromURI, testCase.toURI)
+		if got != testCase.want {
+			t.Errorf("%s: expect price %v per GiB, got %v", testCase.name, testCase.want, got)
+		}
+	}
+}
+
+func TestEstimateEgressCost(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		Status: datav1alpha1.DatasetStatus{
+			UfsTotal: "100.00GiB",
+		},
+	}
+	got := estimateEgressCost(dataset, 0.09)
+	want := "$9.00 (100.00GiB at $0.090/GiB)"
+	if got != want {
+		t.Errorf("expect estimate %q, got %q", want, got)
+	}
+
+	got = estimateEgressCost(&datav1alpha1.Dataset{}, 0.09)
+	want = "unknown (dataset size not reported yet, $0.090/GiB)"
+	if got != want {
+		t.Errorf("expect estimate %q, got %q", want, got)
+	}
+}
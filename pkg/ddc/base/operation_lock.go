@@ -37,6 +37,21 @@ func GetDataOperationKey(object client.Object) string {
 	}.String()
 }
 
+// WaitingError explains why a data operation can't start yet: its structured reason and
+// the reference of the blocking object end up in a Waiting condition on the operation.
+type WaitingError struct {
+	// Reason is a machine-readable reason, e.g. RuntimeNotReady or DataOperationCollision
+	Reason string
+	// BlockingObject references the object the operation is waiting on
+	BlockingObject string
+
+	message string
+}
+
+func (e *WaitingError) Error() string {
+	return fmt.Sprintf("%s: waiting on %s", e.message, e.BlockingObject)
+}
+
 // SetDataOperationInTargetDataset return err if current data operation can not be operated on target dataset,
 // if can, set target dataset OperationRef field to mark the data operation being performed.
 func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, object client.Object,
@@ -51,7 +66,11 @@ func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, objec
 			v1.EventTypeNormal,
 			common.RuntimeNotReady,
 			"Bounded accelerate runtime not ready")
-		return fmt.Errorf("bounded accelerate runtime not ready")
+		return &WaitingError{
+			Reason:         common.RuntimeNotReady,
+			BlockingObject: fmt.Sprintf("%s/%s", targetDataset.Namespace, targetDataset.Name),
+			message:        "the bounded accelerate runtime is not ready yet",
+		}
 	}
 
 	operationTypeName := string(operation.GetOperationType())
@@ -77,7 +96,11 @@ func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, objec
 			ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationCollision,
 				"Found other %s(%s) that is in Executing phase, will backoff",
 				operationTypeName, conflictingDataOpKey)
-			return fmt.Errorf("found other %s that is in Executing phase, will backoff", operationTypeName)
+			return &WaitingError{
+				Reason:         common.DataOperationCollision,
+				BlockingObject: conflictingDataOpKey,
+				message:        fmt.Sprintf("found other %s that is in Executing phase, will backoff", operationTypeName),
+			}
 		}
 
 		ctx.Log.Info("No conflicts detected, try to lock the target dataset")
@@ -0,0 +1,65 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestSetAndClearWaitingCondition(t *testing.T) {
+	opStatus := &datav1alpha1.OperationStatus{Phase: common.PhasePending}
+
+	collision := &WaitingError{
+		Reason:         common.DataOperationCollision,
+		BlockingObject: "fluid/other-load",
+		message:        "found other DataLoad that is in Executing phase, will backoff",
+	}
+	if changed := setWaitingCondition(opStatus, collision); !changed {
+		t.Errorf("expect the first waiting condition to be reported as a change")
+	}
+	if len(opStatus.Conditions) != 1 {
+		t.Fatalf("expect 1 condition, got %v", opStatus.Conditions)
+	}
+	condition := opStatus.Conditions[0]
+	if condition.Type != common.Waiting || condition.Reason != common.DataOperationCollision {
+		t.Errorf("unexpected waiting condition %+v", condition)
+	}
+
+	// the same reason probed again must not be reported as a change
+	if changed := setWaitingCondition(opStatus, collision); changed {
+		t.Errorf("expect no change when the waiting reason stays the same")
+	}
+
+	// a new reason overwrites the waiting condition in place
+	notReady := &WaitingError{
+		Reason:         common.RuntimeNotReady,
+		BlockingObject: "fluid/hbase",
+		message:        "the bounded accelerate runtime is not ready yet",
+	}
+	if changed := setWaitingCondition(opStatus, notReady); !changed {
+		t.Errorf("expect a change when the waiting reason changes")
+	}
+	if len(opStatus.Conditions) != 1 || opStatus.Conditions[0].Reason != common.RuntimeNotReady {
+		t.Errorf("expect the waiting condition to be replaced, got %v", opStatus.Conditions)
+	}
+
+	clearWaitingCondition(opStatus)
+	if len(opStatus.Conditions) != 0 {
+		t.Errorf("expect the waiting condition to be cleared, got %v", opStatus.Conditions)
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/defaults"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -346,7 +347,16 @@ func GetRuntimeInfo(client client.Client, name, namespace string) (runtimeInfo R
 			return runtimeInfo, err
 		}
 		runtimeInfo.SetupFuseDeployMode(alluxioRuntime.Spec.Fuse.Global, alluxioRuntime.Spec.Fuse.NodeSelector)
-		runtimeInfo.SetupFuseCleanPolicy(alluxioRuntime.Spec.Fuse.CleanPolicy)
+		cleanPolicy := alluxioRuntime.Spec.Fuse.CleanPolicy
+		if cleanPolicy == datav1alpha1.NoneCleanPolicy {
+			// the spec doesn't decide, so the layered cluster/namespace defaults may
+			effectiveDefaults, err := defaults.LoadEffective(client, namespace)
+			if err != nil {
+				return runtimeInfo, err
+			}
+			cleanPolicy = datav1alpha1.FuseCleanPolicy(effectiveDefaults.FuseCleanPolicy)
+		}
+		runtimeInfo.SetupFuseCleanPolicy(cleanPolicy)
 	case common.JindoRuntime:
 		jindoRuntime, err := utils.GetJindoRuntime(client, name, namespace)
 		if err != nil {
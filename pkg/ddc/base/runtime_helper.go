@@ -93,6 +93,10 @@ func (info *RuntimeInfo) GetTemplateToInjectForFuse(pvcName string, pvcNamespace
 		info.transformTemplateWithCacheDirDisabled(template)
 	}
 
+	if len(option.CacheBypass) > 0 {
+		info.transformTemplateWithCacheBypass(template, option.CacheBypass)
+	}
+
 	// 2. setup fuse sidecar container when enabling unprivileged sidecar
 	if option.EnableUnprivilegedSidecar {
 		info.transformTemplateWithUnprivilegedSidecarEnabled(template)
@@ -217,6 +221,44 @@ func (info *RuntimeInfo) transformTemplateWithCacheDirDisabled(template *common.
 	template.VolumesToAdd = utils.TrimVolumes(template.VolumesToAdd, cacheDirNames)
 }
 
+// transformTemplateWithCacheBypass makes the fuse sidecar route the pod's IOs around the
+// cache. For alluxio writes are switched to THROUGH so they go straight to the under
+// storage, and the 'all' mode additionally skips caching on reads, so one-off bulk
+// accesses don't evict hot read data. Other engines get the generic FLUID_CACHE_BYPASS
+// env to honor in their fuse entrypoint.
+func (info *RuntimeInfo) transformTemplateWithCacheBypass(template *common.FuseInjectionTemplate, mode string) {
+	if info.runtimeType == common.AlluxioRuntime {
+		javaOpts := "-Dalluxio.user.file.writetype.default=THROUGH"
+		if mode == common.CacheBypassAll {
+			javaOpts += " -Dalluxio.user.file.readtype.default=NO_CACHE"
+		}
+		template.FuseContainer.Env = appendOrMergeEnv(template.FuseContainer.Env, "ALLUXIO_JAVA_OPTS", javaOpts)
+		return
+	}
+
+	template.FuseContainer.Env = append(template.FuseContainer.Env, corev1.EnvVar{
+		Name:  "FLUID_CACHE_BYPASS",
+		Value: mode,
+	})
+}
+
+// appendOrMergeEnv appends value to the named env var of the container, separated by a
+// space when the var already carries a value.
+func appendOrMergeEnv(envs []corev1.EnvVar, name, value string) []corev1.EnvVar {
+	for i, env := range envs {
+		if env.Name != name {
+			continue
+		}
+		if len(env.Value) > 0 {
+			envs[i].Value = env.Value + " " + value
+		} else {
+			envs[i].Value = value
+		}
+		return envs
+	}
+	return append(envs, corev1.EnvVar{Name: name, Value: value})
+}
+
 func getFuseDeviceResourceName() string {
 	return fuseDeviceResourceName
 }
@@ -1171,3 +1171,26 @@ func TestGetFuseDaemonset(t *testing.T) {
 		}
 	}
 }
+
+func TestTransformTemplateWithCacheBypass(t *testing.T) {
+	alluxioInfo := RuntimeInfo{name: "hbase", namespace: "fluid", runtimeType: common.AlluxioRuntime}
+	template := &common.FuseInjectionTemplate{
+		FuseContainer: corev1.Container{
+			Env: []corev1.EnvVar{{Name: "ALLUXIO_JAVA_OPTS", Value: "-Xmx4G"}},
+		},
+	}
+	alluxioInfo.transformTemplateWithCacheBypass(template, common.CacheBypassAll)
+	want := "-Xmx4G -Dalluxio.user.file.writetype.default=THROUGH -Dalluxio.user.file.readtype.default=NO_CACHE"
+	if got := template.FuseContainer.Env[0].Value; got != want {
+		t.Errorf("expected the alluxio java opts to be %q, got %q", want, got)
+	}
+
+	thinInfo := RuntimeInfo{name: "thin", namespace: "fluid", runtimeType: common.ThinRuntime}
+	template = &common.FuseInjectionTemplate{}
+	thinInfo.transformTemplateWithCacheBypass(template, common.CacheBypassWrite)
+	if len(template.FuseContainer.Env) != 1 ||
+		template.FuseContainer.Env[0].Name != "FLUID_CACHE_BYPASS" ||
+		template.FuseContainer.Env[0].Value != common.CacheBypassWrite {
+		t.Errorf("expected the generic FLUID_CACHE_BYPASS env, got %v", template.FuseContainer.Env)
+	}
+}
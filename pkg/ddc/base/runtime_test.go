@@ -27,6 +27,7 @@ import (
 
 	fakeutils "github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -222,6 +223,7 @@ func TestCleanPolicy(t *testing.T) {
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.JuiceFSRuntime{})
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.GooseFSRuntime{})
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.Dataset{})
+	s.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.ConfigMap{})
 
 	// Test Alluxio Runtime
 	alluxioRuntimeDefaultCleanPolicy := v1alpha1.AlluxioRuntime{
@@ -952,6 +954,7 @@ func TestGetRuntimeInfo(t *testing.T) {
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.JuiceFSRuntime{})
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.EACRuntime{})
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.Dataset{})
+	s.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.ConfigMap{})
 	_ = v1.AddToScheme(s)
 	alluxioRuntimeObjs := []runtime.Object{}
 	goosefsRuntimeObjs := []runtime.Object{}
@@ -1202,6 +1205,7 @@ func TestGetRuntimeStatus(t *testing.T) {
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.EACRuntime{})
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.ThinRuntime{})
 	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.Dataset{})
+	s.AddKnownTypes(corev1.SchemeGroupVersion, &corev1.ConfigMap{})
 
 	_ = v1.AddToScheme(s)
 	alluxioRuntimeObjs := []runtime.Object{}
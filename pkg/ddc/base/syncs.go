@@ -60,11 +60,15 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 	}
 
 	// 3. Check healthy
+	runtimeVersion, workerReplicas := runtimeFleetAttributes(ctx.Runtime)
 	err = t.Implement.CheckRuntimeHealthy()
 	if err != nil {
-		metrics.GetRuntimeMetrics(ctx.Runtime.GetObjectKind().GroupVersionKind().Kind, ctx.Namespace, ctx.Name).HealthCheckErrorInc()
+		runtimeMetrics := metrics.GetRuntimeMetrics(ctx.Runtime.GetObjectKind().GroupVersionKind().Kind, ctx.Namespace, ctx.Name)
+		runtimeMetrics.HealthCheckErrorInc()
+		runtimeMetrics.SetFleetInfo(runtimeVersion, workerReplicas, false)
 		return
 	}
+	metrics.GetRuntimeMetrics(ctx.Runtime.GetObjectKind().GroupVersionKind().Kind, ctx.Namespace, ctx.Name).SetFleetInfo(runtimeVersion, workerReplicas, true)
 
 	// 4. Update runtime status
 	if permitSyncEngineStatus {
@@ -57,6 +57,16 @@ func (j *JuiceFSEngine) transformFuse(runtime *datav1alpha1.JuiceFSRuntime, data
 		return err
 	}
 
+	// apply the dataset's declared default permissions for newly created files,
+	// explicit mount options take precedence
+	if dataset.Spec.FileDefaults != nil {
+		if umask := dataset.Spec.FileDefaults.EffectiveUmask(); umask != "" {
+			if _, found := option["umask"]; !found {
+				option["umask"] = umask
+			}
+		}
+	}
+
 	// transform format cmd
 	j.genFormatCmd(value, runtime.Spec.Configs)
 
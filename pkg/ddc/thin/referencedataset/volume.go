@@ -18,6 +18,7 @@ package referencedataset
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 
@@ -95,6 +96,15 @@ func createFusePersistentVolume(client client.Client, virtualRuntime base.Runtim
 			copiedPvSpec.CSI.VolumeAttributes[common.VolumeAttrFluidSubPath] = subPaths[0]
 		}
 
+		// set the masking rules so the CSI plugin masks the matched paths when publishing
+		maskedPaths, err := maskedPathsForVirtualDataset(virtualDataset)
+		if err != nil {
+			return accessModes, err
+		}
+		if maskedPaths != "" {
+			copiedPvSpec.CSI.VolumeAttributes[common.VolumeAttrFluidMaskedPaths] = maskedPaths
+		}
+
 		// set the accessModes
 		// only allow readOnly when physical
 		accessModes = accessModesForVirtualDataset(virtualDataset, copiedPvSpec.AccessModes)
@@ -128,6 +138,20 @@ func createFusePersistentVolume(client client.Client, virtualRuntime base.Runtim
 	return accessModes, err
 }
 
+// maskedPathsForVirtualDataset serializes the virtual dataset's data masking rules into
+// the value of the masked paths volume attribute, or an empty string when no masking is set
+func maskedPathsForVirtualDataset(virtualDataset *datav1alpha1.Dataset) (string, error) {
+	if virtualDataset.Spec.DataMasking == nil || len(virtualDataset.Spec.DataMasking.Rules) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(virtualDataset.Spec.DataMasking.Rules)
+	if err != nil {
+		return "", fmt.Errorf("fail to encode the data masking rules of dataset \"%s/%s\": %v",
+			virtualDataset.GetNamespace(), virtualDataset.GetName(), err)
+	}
+	return string(encoded), nil
+}
+
 // accessModesForVirtualDataset generates accessMode based on virtualDataset and copiedPvSpec
 func accessModesForVirtualDataset(virtualDataset *datav1alpha1.Dataset, modes []corev1.PersistentVolumeAccessMode) []corev1.PersistentVolumeAccessMode {
 	accessModes := virtualDataset.Spec.AccessModes
@@ -140,6 +164,11 @@ func accessModesForVirtualDataset(virtualDataset *datav1alpha1.Dataset, modes []
 		}
 	}
 
+	// a masked view must never allow writing back to the raw dataset
+	if virtualDataset.Spec.DataMasking != nil {
+		readOnly = true
+	}
+
 	if len(accessModes) == 0 || readOnly {
 		accessModes = []corev1.PersistentVolumeAccessMode{
 			corev1.ReadOnlyMany,
@@ -324,6 +324,27 @@ func Test_accessModesForVirtualDataset(t *testing.T) {
 				},
 			},
 			want: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+		}, {
+			name: "masked_dataset_forced_read_only",
+			args: args{
+				virtualDataset: &datav1alpha1.Dataset{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "v1",
+					},
+					Spec: datav1alpha1.DatasetSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+						DataMasking: &datav1alpha1.DataMasking{
+							Rules: []datav1alpha1.DataMaskingRule{
+								{PathPattern: "raw/*/pii.csv", Strategy: datav1alpha1.HideMaskingStrategy},
+							},
+						},
+					},
+				},
+				copiedPvSpec: &corev1.PersistentVolumeSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+				},
+			},
+			want: []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany},
 		},
 	}
 	for _, tt := range tests {
@@ -334,3 +355,42 @@ func Test_accessModesForVirtualDataset(t *testing.T) {
 		})
 	}
 }
+
+func Test_maskedPathsForVirtualDataset(t *testing.T) {
+	datasetWithoutMasking := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "plain",
+			Namespace: "fluid",
+		},
+	}
+	got, err := maskedPathsForVirtualDataset(datasetWithoutMasking)
+	if err != nil {
+		t.Errorf("fail to serialize the masking rules with error %v", err)
+	}
+	if got != "" {
+		t.Errorf("expect no masked paths attribute for a dataset without masking, got %v", got)
+	}
+
+	maskedDataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "masked",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.DatasetSpec{
+			DataMasking: &datav1alpha1.DataMasking{
+				Rules: []datav1alpha1.DataMaskingRule{
+					{PathPattern: "raw/*/pii.csv", Strategy: datav1alpha1.HideMaskingStrategy},
+					{PathPattern: "logs/*.audit", Strategy: datav1alpha1.RedactMaskingStrategy},
+				},
+			},
+		},
+	}
+	got, err = maskedPathsForVirtualDataset(maskedDataset)
+	if err != nil {
+		t.Errorf("fail to serialize the masking rules with error %v", err)
+	}
+	want := `[{"pathPattern":"raw/*/pii.csv","strategy":"hide"},{"pathPattern":"logs/*.audit","strategy":"redact"}]`
+	if got != want {
+		t.Errorf("maskedPathsForVirtualDataset() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ConfigMapName is the well-known name of the ConfigMap a defaults layer lives in.
+	// The ConfigMap in the fluid-system namespace provides the cluster-level layer, the
+	// one in a dataset's namespace provides the namespace-level layer.
+	ConfigMapName = "fluid-defaults"
+
+	// The keys a defaults ConfigMap can set
+	KeyRuntimeImage         = "runtimeImage"
+	KeyFuseImage            = "fuseImage"
+	KeyFuseCleanPolicy      = "fuseCleanPolicy"
+	KeyMetadataSyncInterval = "metadataSyncInterval"
+)
+
+// Defaults carries the settings a cluster admin or a namespace owner can default for the
+// runtimes under them. A setting declared on the runtime spec always wins over any layer
+// here; within the layers, the namespace one wins over the cluster one.
+type Defaults struct {
+	// RuntimeImage is the default runtime image in '{repo}:{tag}' form
+	RuntimeImage string
+
+	// FuseImage is the default fuse image in '{repo}:{tag}' form
+	FuseImage string
+
+	// FuseCleanPolicy is the default fuse clean policy, 'OnDemand' or 'OnRuntimeDeleted'
+	FuseCleanPolicy string
+
+	// MetadataSyncInterval is the default metadata sync interval of the under storage
+	MetadataSyncInterval string
+}
+
+// LoadEffective merges the cluster-level defaults ConfigMap in the fluid-system
+// namespace with the namespace-level one of the given namespace. A missing layer simply
+// contributes nothing.
+func LoadEffective(client client.Client, namespace string) (effective Defaults, err error) {
+	layers := []string{common.NamespaceFluidSystem}
+	if namespace != common.NamespaceFluidSystem {
+		layers = append(layers, namespace)
+	}
+
+	for _, layerNamespace := range layers {
+		configMap, err := kubeclient.GetConfigmapByName(client, ConfigMapName, layerNamespace)
+		if err != nil {
+			return effective, err
+		}
+		if configMap == nil {
+			continue
+		}
+		effective.overlay(configMap.Data)
+	}
+
+	return effective, nil
+}
+
+// overlay applies the keys the layer sets on top of the lower layers.
+func (d *Defaults) overlay(data map[string]string) {
+	if value, found := data[KeyRuntimeImage]; found {
+		d.RuntimeImage = value
+	}
+	if value, found := data[KeyFuseImage]; found {
+		d.FuseImage = value
+	}
+	if value, found := data[KeyFuseCleanPolicy]; found {
+		d.FuseCleanPolicy = value
+	}
+	if value, found := data[KeyMetadataSyncInterval]; found {
+		d.MetadataSyncInterval = value
+	}
+}
+
+// AsMap returns the settings the merged layers actually default, keyed like the
+// ConfigMap, for surfacing in the runtime status.
+func (d Defaults) AsMap() map[string]string {
+	entries := map[string]string{}
+	if len(d.RuntimeImage) > 0 {
+		entries[KeyRuntimeImage] = d.RuntimeImage
+	}
+	if len(d.FuseImage) > 0 {
+		entries[KeyFuseImage] = d.FuseImage
+	}
+	if len(d.FuseCleanPolicy) > 0 {
+		entries[KeyFuseCleanPolicy] = d.FuseCleanPolicy
+	}
+	if len(d.MetadataSyncInterval) > 0 {
+		entries[KeyMetadataSyncInterval] = d.MetadataSyncInterval
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return entries
+}
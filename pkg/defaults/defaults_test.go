@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestLoadEffective(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = v1.AddToScheme(testScheme)
+
+	testConfigMaps := []runtime.Object{
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: common.NamespaceFluidSystem},
+			Data: map[string]string{
+				KeyRuntimeImage:         "registry.example.com/alluxio/alluxio:2.9.0",
+				KeyFuseImage:            "registry.example.com/alluxio/alluxio-fuse:2.9.0",
+				KeyMetadataSyncInterval: "10s",
+			},
+		},
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: "big-data"},
+			Data: map[string]string{
+				KeyMetadataSyncInterval: "1m",
+				KeyFuseCleanPolicy:      "OnDemand",
+			},
+		},
+	}
+
+	client := fake.NewFakeClientWithScheme(testScheme, testConfigMaps...)
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      Defaults
+	}{
+		{
+			name:      "namespace layer overrides the cluster layer key by key",
+			namespace: "big-data",
+			want: Defaults{
+				RuntimeImage:         "registry.example.com/alluxio/alluxio:2.9.0",
+				FuseImage:            "registry.example.com/alluxio/alluxio-fuse:2.9.0",
+				FuseCleanPolicy:      "OnDemand",
+				MetadataSyncInterval: "1m",
+			},
+		},
+		{
+			name:      "namespace without its own layer inherits the cluster layer",
+			namespace: "default",
+			want: Defaults{
+				RuntimeImage:         "registry.example.com/alluxio/alluxio:2.9.0",
+				FuseImage:            "registry.example.com/alluxio/alluxio-fuse:2.9.0",
+				MetadataSyncInterval: "10s",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := LoadEffective(client, tt.namespace)
+		if err != nil {
+			t.Errorf("testcase %s: LoadEffective() got unexpected error %v", tt.name, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("testcase %s: LoadEffective() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultsAsMap(t *testing.T) {
+	empty := Defaults{}
+	if entries := empty.AsMap(); entries != nil {
+		t.Errorf("AsMap() of empty defaults = %v, want nil", entries)
+	}
+
+	partial := Defaults{FuseCleanPolicy: "OnDemand"}
+	want := map[string]string{KeyFuseCleanPolicy: "OnDemand"}
+	if entries := partial.AsMap(); !reflect.DeepEqual(entries, want) {
+		t.Errorf("AsMap() = %v, want %v", entries, want)
+	}
+}
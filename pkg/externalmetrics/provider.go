@@ -0,0 +1,165 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalmetrics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// The external metric names served to the HPA.
+const (
+	MetricCacheHitRatio    = "dataset_cache_hit_ratio"
+	MetricCachedPercentage = "dataset_cached_percentage"
+	MetricPendingDataLoads = "dataset_pending_dataloads"
+)
+
+// metricsProvider computes external metric values on demand from the datasets and data
+// operations in the cluster, instead of scraping the engines directly.
+type metricsProvider struct {
+	client client.Client
+	log    logr.Logger
+}
+
+// listMetricValues resolves one external metric for all datasets in the namespace that
+// match the selector. Each sample carries a "dataset" metric label so HPA selectors can
+// target a single dataset.
+func (p *metricsProvider) listMetricValues(namespace, metric string, selector labels.Selector) ([]externalMetricValue, error) {
+	datasets := &datav1alpha1.DatasetList{}
+	err := p.client.List(context.TODO(), datasets, &client.ListOptions{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+
+	values := []externalMetricValue{}
+	for i := range datasets.Items {
+		dataset := &datasets.Items[i]
+		metricLabels := labels.Set{"dataset": dataset.Name}
+		if !selector.Matches(metricLabels) {
+			continue
+		}
+
+		value, found, err := p.datasetMetricValue(dataset, metric)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+
+		values = append(values, externalMetricValue{
+			MetricName:   metric,
+			MetricLabels: metricLabels,
+			Timestamp:    metav1.Now(),
+			Value:        value,
+		})
+	}
+
+	return values, nil
+}
+
+func (p *metricsProvider) datasetMetricValue(dataset *datav1alpha1.Dataset, metric string) (value resource.Quantity, found bool, err error) {
+	switch metric {
+	case MetricCacheHitRatio:
+		return parsePercentageQuantity(dataset.Status.CacheStates[common.CacheHitRatio])
+	case MetricCachedPercentage:
+		return parsePercentageQuantity(dataset.Status.CacheStates[common.CachedPercentage])
+	case MetricPendingDataLoads:
+		pending, err := p.countPendingDataLoads(dataset)
+		if err != nil {
+			return value, false, err
+		}
+		return *resource.NewQuantity(pending, resource.DecimalSI), true, nil
+	default:
+		return value, false, fmt.Errorf("unknown external metric %s", metric)
+	}
+}
+
+// countPendingDataLoads counts the DataLoads targeting the dataset that haven't finished
+// yet, a signal that more workers could speed up ingest.
+func (p *metricsProvider) countPendingDataLoads(dataset *datav1alpha1.Dataset) (pending int64, err error) {
+	dataLoads := &datav1alpha1.DataLoadList{}
+	err = p.client.List(context.TODO(), dataLoads, &client.ListOptions{Namespace: dataset.Namespace})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dataLoad := range dataLoads.Items {
+		if dataLoad.Spec.Dataset.Name != dataset.Name {
+			continue
+		}
+		if dataLoad.Status.Phase == common.PhasePending || dataLoad.Status.Phase == common.PhaseExecuting {
+			pending++
+		}
+	}
+
+	return
+}
+
+// parsePercentageQuantity turns a cache state percentage like "75.0%" into a quantity,
+// e.g. "75" so HPA targets read naturally.
+func parsePercentageQuantity(percentage string) (value resource.Quantity, found bool, err error) {
+	percentage = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(percentage), "%"))
+	if len(percentage) == 0 {
+		return value, false, nil
+	}
+
+	ratio, err := strconv.ParseFloat(percentage, 64)
+	if err != nil {
+		// a dataset not bound yet reports non-numeric placeholders, skip it
+		return value, false, nil
+	}
+
+	return *resource.NewMilliQuantity(int64(ratio*1000), resource.DecimalSI), true, nil
+}
+
+// externalMetricValue mirrors external.metrics.k8s.io/v1beta1 ExternalMetricValue, which
+// is not vendored.
+type externalMetricValue struct {
+	MetricName   string            `json:"metricName"`
+	MetricLabels map[string]string `json:"metricLabels"`
+	Timestamp    metav1.Time       `json:"timestamp"`
+	Value        resource.Quantity `json:"value"`
+}
+
+// externalMetricValueList mirrors external.metrics.k8s.io/v1beta1 ExternalMetricValueList.
+type externalMetricValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []externalMetricValue `json:"items"`
+}
+
+func newExternalMetricValueList(values []externalMetricValue) *externalMetricValueList {
+	return &externalMetricValueList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ExternalMetricValueList",
+			APIVersion: apiGroupVersion,
+		},
+		Items: values,
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalmetrics
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListMetricValues(t *testing.T) {
+	hbase := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+		},
+		Status: datav1alpha1.DatasetStatus{
+			CacheStates: common.CacheStateList{
+				common.CacheHitRatio:    "75.0%",
+				common.CachedPercentage: "50.0%",
+			},
+		},
+	}
+	spark := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark",
+			Namespace: "fluid",
+		},
+	}
+	dataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase-load",
+			Namespace: "fluid",
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "fluid"},
+		},
+		Status: datav1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+	}
+
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	provider := &metricsProvider{
+		client: fake.NewFakeClientWithScheme(s, hbase, spark, dataLoad),
+		log:    fake.NullLogger(),
+	}
+
+	values, err := provider.listMetricValues("fluid", MetricCacheHitRatio, labels.Everything())
+	if err != nil {
+		t.Fatalf("fail to list the cache hit ratio: %v", err)
+	}
+	// the dataset without cache states must be skipped instead of reported as zero
+	if len(values) != 1 || values[0].MetricLabels["dataset"] != "hbase" {
+		t.Fatalf("expect one sample for dataset hbase, got %v", values)
+	}
+	if values[0].Value.MilliValue() != 75000 {
+		t.Errorf("expect a cache hit ratio of 75, got %s", values[0].Value.String())
+	}
+
+	selector, _ := labels.Parse("dataset=spark")
+	values, err = provider.listMetricValues("fluid", MetricPendingDataLoads, selector)
+	if err != nil {
+		t.Fatalf("fail to list the pending dataloads: %v", err)
+	}
+	if len(values) != 1 || values[0].Value.Value() != 0 {
+		t.Errorf("expect 0 pending dataloads for dataset spark, got %v", values)
+	}
+
+	values, err = provider.listMetricValues("fluid", MetricPendingDataLoads, labels.Everything())
+	if err != nil {
+		t.Fatalf("fail to list the pending dataloads: %v", err)
+	}
+	for _, value := range values {
+		if value.MetricLabels["dataset"] == "hbase" && value.Value.Value() != 1 {
+			t.Errorf("expect 1 pending dataload for dataset hbase, got %s", value.Value.String())
+		}
+	}
+
+	if _, err = provider.listMetricValues("fluid", "no_such_metric", labels.Everything()); err == nil {
+		t.Errorf("expect an error for an unknown metric")
+	}
+}
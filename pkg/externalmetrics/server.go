@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalmetrics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/webhook/generator"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/webhook/writer"
+)
+
+const (
+	apiGroupVersion = "external.metrics.k8s.io/v1beta1"
+	apiPrefix       = "/apis/" + apiGroupVersion
+
+	// serviceName is the service the adapter's APIService is expected to point at; the
+	// generated serving certificate is issued for its DNS name
+	serviceName = "fluid-external-metrics"
+)
+
+var _ manager.Runnable = &Server{}
+
+// Server exposes Fluid's data-path signals through the Kubernetes external metrics API
+// shape, so an HPA can scale runtime workers or application replicas on dataset cache
+// hit ratio, cached percentage or pending DataLoads. The routes are:
+//
+//	GET /apis/external.metrics.k8s.io/v1beta1
+//	GET /apis/external.metrics.k8s.io/v1beta1/namespaces/{namespace}/{metric}?labelSelector=dataset%3Dhbase
+//
+// The adapter always serves HTTPS: kube-aggregator only connects to APIService backends
+// over TLS, so a plain HTTP adapter could never be consumed by an HPA.
+type Server struct {
+	addr     string
+	certDir  string
+	log      logr.Logger
+	provider *metricsProvider
+}
+
+// NewServer builds the external metrics adapter. certDir holds the TLS serving
+// certificate (writer.ServerCertName2/writer.ServerKeyName2, i.e. 'tls.crt'/'tls.key');
+// when the files are absent a self-signed certificate is generated there with the
+// webhook certificate machinery.
+func NewServer(client client.Client, addr, certDir string, log logr.Logger) *Server {
+	return &Server{
+		addr:    addr,
+		certDir: certDir,
+		log:     log,
+		provider: &metricsProvider{
+			client: client,
+			log:    log,
+		},
+	}
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	certFile, keyFile, err := s.ensureServingCert()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(apiPrefix, s.serveAPIResourceList)
+	mux.HandleFunc(apiPrefix+"/", s.serveMetric)
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.log.Info("Starting the external metrics adapter", "addr", s.addr, "certDir", s.certDir)
+		errCh <- server.ListenAndServeTLS(certFile, keyFile)
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		return err
+	}
+}
+
+// ensureServingCert returns the TLS serving certificate files under the cert dir,
+// generating a self-signed certificate for the adapter's service there first when a
+// provisioner (e.g. cert-manager) hasn't mounted one.
+func (s *Server) ensureServingCert() (certFile, keyFile string, err error) {
+	certFile = filepath.Join(s.certDir, writer.ServerCertName2)
+	keyFile = filepath.Join(s.certDir, writer.ServerKeyName2)
+
+	if _, certErr := os.Stat(certFile); certErr == nil {
+		if _, keyErr := os.Stat(keyFile); keyErr == nil {
+			return certFile, keyFile, nil
+		}
+	}
+
+	namespace, err := utils.GetEnvByKey(common.MyPodNamespace)
+	if err != nil || len(namespace) == 0 {
+		namespace = common.NamespaceFluidSystem
+	}
+	commonName := generator.ServiceToCommonName(namespace, serviceName)
+
+	s.log.Info("Generating a self-signed serving certificate for the external metrics adapter", "commonName", commonName)
+	certs, err := (&generator.SelfSignedCertGenerator{}).Generate(commonName)
+	if err != nil {
+		return certFile, keyFile, err
+	}
+	if err = writer.WriteCertsToDir(s.certDir, certs); err != nil {
+		return certFile, keyFile, err
+	}
+	return certFile, keyFile, nil
+}
+
+// serveAPIResourceList answers the external metrics API discovery request.
+func (s *Server) serveAPIResourceList(writer http.ResponseWriter, request *http.Request) {
+	resources := []metav1.APIResource{}
+	for _, metric := range []string{MetricCacheHitRatio, MetricCachedPercentage, MetricPendingDataLoads} {
+		resources = append(resources, metav1.APIResource{
+			Name:       metric,
+			Namespaced: true,
+			Kind:       "ExternalMetricValueList",
+			Verbs:      []string{"get"},
+		})
+	}
+	s.writeJSON(writer, &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: apiGroupVersion,
+		APIResources: resources,
+	})
+}
+
+// serveMetric dispatches /apis/external.metrics.k8s.io/v1beta1/namespaces/{namespace}/{metric}.
+func (s *Server) serveMetric(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(request.URL.Path, apiPrefix+"/"), "/"), "/")
+	if len(segments) != 3 || segments[0] != "namespaces" {
+		http.Error(writer, "expect path "+apiPrefix+"/namespaces/{namespace}/{metric}", http.StatusNotFound)
+		return
+	}
+	namespace, metric := segments[1], segments[2]
+
+	selector := labels.Everything()
+	if rawSelector := request.URL.Query().Get("labelSelector"); len(rawSelector) > 0 {
+		var err error
+		if selector, err = labels.Parse(rawSelector); err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	values, err := s.provider.listMetricValues(namespace, metric, selector)
+	if err != nil {
+		s.log.Error(err, "failed to resolve the external metric", "namespace", namespace, "metric", metric)
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSON(writer, newExternalMetricValueList(values))
+}
+
+func (s *Server) writeJSON(writer http.ResponseWriter, object interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(writer).Encode(object); err != nil {
+		s.log.Error(err, "failed to encode the response")
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// mountFailureWindow bounds how far back recent fuse mount failures count against the
+// dataset's health.
+const mountFailureWindow = 30 * time.Minute
+
+// DatasetHealth is the per-dataset health rollup the dashboard endpoint serves. The
+// score starts at 100 and each unhealthy factor subtracts its weight, so dashboards can
+// alert on the score while the factors explain what degraded it.
+type DatasetHealth struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	Phase     string         `json:"phase"`
+	Score     int            `json:"score"`
+	Factors   []HealthFactor `json:"factors"`
+}
+
+// HealthFactor is one signal contributing to the dataset's health score.
+type HealthFactor struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Weight  int    `json:"weight"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// Collector aggregates the health signals of datasets from the cluster state.
+type Collector struct {
+	client client.Client
+}
+
+// NewCollector builds a health collector backed by the given client.
+func NewCollector(client client.Client) *Collector {
+	return &Collector{client: client}
+}
+
+// Collect rolls the dataset's health signals up into a single scored report: the
+// dataset phase, the engine components (workers), the fuse pods, recent mount failures
+// reported by the CSI plugin, and the under storage connectivity self-test verdict.
+func (c *Collector) Collect(ctx context.Context, namespace, name string) (health DatasetHealth, err error) {
+	dataset := &datav1alpha1.Dataset{}
+	if err = c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, dataset); err != nil {
+		return
+	}
+	return c.collectDataset(ctx, dataset), nil
+}
+
+// CollectAll rolls up the health of every dataset in the given namespace, or of all
+// namespaces when empty.
+func (c *Collector) CollectAll(ctx context.Context, namespace string) (healths []DatasetHealth, err error) {
+	datasets := &datav1alpha1.DatasetList{}
+	if err = c.client.List(ctx, datasets, client.InNamespace(namespace)); err != nil {
+		return
+	}
+
+	healths = []DatasetHealth{}
+	for i := range datasets.Items {
+		healths = append(healths, c.collectDataset(ctx, &datasets.Items[i]))
+	}
+	return
+}
+
+func (c *Collector) collectDataset(ctx context.Context, dataset *datav1alpha1.Dataset) (health DatasetHealth) {
+	health = DatasetHealth{
+		Namespace: dataset.Namespace,
+		Name:      dataset.Name,
+		Phase:     string(dataset.Status.Phase),
+		Factors:   []HealthFactor{},
+	}
+
+	health.addFactor(HealthFactor{
+		Name:    "DatasetBound",
+		Healthy: dataset.Status.Phase == datav1alpha1.BoundDatasetPhase,
+		Weight:  40,
+		Detail:  fmt.Sprintf("the dataset is in phase %s", dataset.Status.Phase),
+	})
+
+	if len(dataset.Status.Runtimes) > 0 {
+		runtime := dataset.Status.Runtimes[0]
+		health.addFactor(c.workerHealth(ctx, runtime))
+		health.addFactor(c.fuseHealth(ctx, runtime))
+	}
+
+	health.addFactor(c.mountHealth(ctx, dataset))
+
+	if connectivity := connectivityHealth(dataset); connectivity != nil {
+		health.addFactor(*connectivity)
+	}
+
+	health.Score = 100
+	for _, factor := range health.Factors {
+		if !factor.Healthy {
+			health.Score -= factor.Weight
+		}
+	}
+	if health.Score < 0 {
+		health.Score = 0
+	}
+	return
+}
+
+func (health *DatasetHealth) addFactor(factor HealthFactor) {
+	health.Factors = append(health.Factors, factor)
+}
+
+// workerHealth reports the readiness of the runtime's worker statefulset.
+func (c *Collector) workerHealth(ctx context.Context, runtime datav1alpha1.Runtime) HealthFactor {
+	factor := HealthFactor{Name: "WorkersReady", Weight: 25}
+
+	workers, err := kubeclient.GetStatefulSet(c.client, fmt.Sprintf("%s-worker", runtime.Name), runtime.Namespace)
+	if err != nil {
+		factor.Detail = fmt.Sprintf("not able to read the worker statefulset: %v", err)
+		return factor
+	}
+
+	desired := int32(1)
+	if workers.Spec.Replicas != nil {
+		desired = *workers.Spec.Replicas
+	}
+	factor.Healthy = workers.Status.ReadyReplicas >= desired
+	factor.Detail = fmt.Sprintf("%d of %d workers ready", workers.Status.ReadyReplicas, desired)
+	return factor
+}
+
+// fuseHealth reports the readiness of the runtime's fuse daemonset.
+func (c *Collector) fuseHealth(ctx context.Context, runtime datav1alpha1.Runtime) HealthFactor {
+	factor := HealthFactor{Name: "FusePodsReady", Weight: 20}
+
+	fuse, err := kubeclient.GetDaemonset(c.client, fmt.Sprintf("%s-fuse", runtime.Name), runtime.Namespace)
+	if err != nil {
+		factor.Detail = fmt.Sprintf("not able to read the fuse daemonset: %v", err)
+		return factor
+	}
+
+	factor.Healthy = fuse.Status.NumberUnavailable == 0
+	factor.Detail = fmt.Sprintf("%d of %d fuse pods ready", fuse.Status.NumberReady, fuse.Status.DesiredNumberScheduled)
+	return factor
+}
+
+// mountHealth reports whether the CSI plugin signalled fuse mount failures for pods
+// consuming the dataset recently.
+func (c *Collector) mountHealth(ctx context.Context, dataset *datav1alpha1.Dataset) HealthFactor {
+	factor := HealthFactor{Name: "MountHealth", Weight: 20, Healthy: true, Detail: "no recent mount failures"}
+
+	events := &corev1.EventList{}
+	if err := c.client.List(ctx, events, client.InNamespace(dataset.Namespace)); err != nil {
+		factor.Detail = fmt.Sprintf("not able to list events: %v", err)
+		return factor
+	}
+
+	recentFailures := 0
+	for _, event := range events.Items {
+		if event.Reason != common.FuseMountFailed {
+			continue
+		}
+		if time.Since(event.LastTimestamp.Time) > mountFailureWindow {
+			continue
+		}
+		recentFailures++
+	}
+	if recentFailures > 0 {
+		factor.Healthy = false
+		factor.Detail = fmt.Sprintf("%d fuse mount failures reported by the CSI plugin in the last %v", recentFailures, mountFailureWindow)
+	}
+	return factor
+}
+
+// connectivityHealth reports the verdict of the under storage connectivity self-test,
+// if the dataset ran one.
+func connectivityHealth(dataset *datav1alpha1.Dataset) *HealthFactor {
+	_, cond := utils.GetDatasetCondition(dataset.Status.Conditions, datav1alpha1.DatasetConnectivityVerified)
+	if cond == nil {
+		return nil
+	}
+
+	return &HealthFactor{
+		Name:    "UfsConnectivity",
+		Healthy: cond.Status == corev1.ConditionTrue,
+		Weight:  15,
+		Detail:  cond.Message,
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	utilpointer "k8s.io/utils/pointer"
+)
+
+func newTestCollector(t *testing.T, objects ...runtime.Object) *Collector {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := datav1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the fluid scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the core scheme: %v", err)
+	}
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the apps scheme: %v", err)
+	}
+	return NewCollector(fake.NewFakeClientWithScheme(s, objects...))
+}
+
+func boundDataset(namespace, name string) *datav1alpha1.Dataset {
+	return &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status: datav1alpha1.DatasetStatus{
+			Phase: datav1alpha1.BoundDatasetPhase,
+			Runtimes: []datav1alpha1.Runtime{
+				{Name: name, Namespace: namespace, Type: common.AlluxioRuntime},
+			},
+		},
+	}
+}
+
+func TestCollectHealthyDataset(t *testing.T) {
+	collector := newTestCollector(t,
+		boundDataset("default", "hbase"),
+		// the engines name the workloads '{name}-worker' and '{name}-fuse'
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker", Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: utilpointer.Int32Ptr(2)},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 2},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-fuse", Namespace: "default"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 2},
+		},
+	)
+
+	health, err := collector.Collect(context.TODO(), "default", "hbase")
+	if err != nil {
+		t.Fatalf("Collect() got unexpected error %v", err)
+	}
+
+	if health.Score != 100 {
+		t.Errorf("Collect() score = %d, want 100, factors: %+v", health.Score, health.Factors)
+	}
+	for _, factor := range health.Factors {
+		if !factor.Healthy {
+			t.Errorf("Collect() factor %s unhealthy: %s", factor.Name, factor.Detail)
+		}
+	}
+}
+
+func TestCollectDegradedDataset(t *testing.T) {
+	collector := newTestCollector(t,
+		boundDataset("default", "hbase"),
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker", Namespace: "default"},
+			Spec:       appsv1.StatefulSetSpec{Replicas: utilpointer.Int32Ptr(2)},
+			Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-fuse", Namespace: "default"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 1, NumberUnavailable: 1},
+		},
+		&corev1.Event{
+			ObjectMeta:    metav1.ObjectMeta{Name: "mount-failure", Namespace: "default"},
+			Reason:        common.FuseMountFailed,
+			LastTimestamp: metav1.NewTime(time.Now()),
+		},
+	)
+
+	health, err := collector.Collect(context.TODO(), "default", "hbase")
+	if err != nil {
+		t.Fatalf("Collect() got unexpected error %v", err)
+	}
+
+	// workers (25), fuse (20) and mount failures (20) dock the bound dataset to 35
+	if health.Score != 35 {
+		t.Errorf("Collect() score = %d, want 35, factors: %+v", health.Score, health.Factors)
+	}
+
+	wantUnhealthy := map[string]bool{"WorkersReady": true, "FusePodsReady": true, "MountHealth": true}
+	for _, factor := range health.Factors {
+		if factor.Healthy == wantUnhealthy[factor.Name] {
+			t.Errorf("Collect() factor %s healthy = %v, detail: %s", factor.Name, factor.Healthy, factor.Detail)
+		}
+	}
+}
+
+func TestCollectConnectivityVerdict(t *testing.T) {
+	dataset := boundDataset("default", "hbase")
+	dataset.Status.Conditions = []datav1alpha1.DatasetCondition{
+		{
+			Type:    datav1alpha1.DatasetConnectivityVerified,
+			Status:  corev1.ConditionFalse,
+			Message: "the connectivity check job failed",
+		},
+	}
+	collector := newTestCollector(t, dataset)
+
+	health, err := collector.Collect(context.TODO(), "default", "hbase")
+	if err != nil {
+		t.Fatalf("Collect() got unexpected error %v", err)
+	}
+
+	var found bool
+	for _, factor := range health.Factors {
+		if factor.Name == "UfsConnectivity" {
+			found = true
+			if factor.Healthy {
+				t.Errorf("Collect() reported healthy connectivity for a failed self-test")
+			}
+		}
+	}
+	if !found {
+		t.Errorf("Collect() misses the UfsConnectivity factor, factors: %+v", health.Factors)
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	collector := newTestCollector(t,
+		boundDataset("default", "hbase"),
+		boundDataset("other", "spark"),
+	)
+
+	healths, err := collector.CollectAll(context.TODO(), "default")
+	if err != nil {
+		t.Fatalf("CollectAll() got unexpected error %v", err)
+	}
+	if len(healths) != 1 || healths[0].Name != "hbase" {
+		t.Errorf("CollectAll() scoped to 'default' = %+v, want only hbase", healths)
+	}
+
+	healths, err = collector.CollectAll(context.TODO(), "")
+	if err != nil {
+		t.Fatalf("CollectAll() got unexpected error %v", err)
+	}
+	if len(healths) != 2 {
+		t.Errorf("CollectAll() across namespaces returned %d datasets, want 2", len(healths))
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+var _ manager.Runnable = &Server{}
+
+// Server exposes the per-dataset health rollup over HTTP for the CLI and dashboards to
+// consume. The routes are:
+//
+//	GET /v1/health/datasets                        all datasets
+//	GET /v1/health/datasets/{namespace}            one namespace
+//	GET /v1/health/datasets/{namespace}/{name}     one dataset
+type Server struct {
+	addr      string
+	log       logr.Logger
+	collector *Collector
+}
+
+func NewServer(client client.Client, addr string, log logr.Logger) *Server {
+	return &Server{
+		addr:      addr,
+		log:       log,
+		collector: NewCollector(client),
+	}
+}
+
+func (s *Server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/health/datasets", s.serveHealth)
+	mux.HandleFunc("/v1/health/datasets/", s.serveHealth)
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		s.log.Info("Starting the health dashboard API server", "addr", s.addr)
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		return err
+	}
+}
+
+// serveHealth dispatches /v1/health/datasets[/{namespace}[/{name}]] requests.
+func (s *Server) serveHealth(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	path := strings.Trim(strings.TrimPrefix(request.URL.Path, "/v1/health/datasets"), "/")
+	var (
+		payload interface{}
+		err     error
+	)
+	switch segments := strings.Split(path, "/"); {
+	case len(path) == 0:
+		payload, err = s.collector.CollectAll(request.Context(), "")
+	case len(segments) == 1:
+		payload, err = s.collector.CollectAll(request.Context(), segments[0])
+	case len(segments) == 2:
+		payload, err = s.collector.Collect(request.Context(), segments[0], segments[1])
+	default:
+		http.Error(writer, "expect path /v1/health/datasets[/{namespace}[/{name}]]", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(writer).Encode(payload); err != nil {
+		s.log.Error(err, "Failed to write the health payload")
+	}
+}
@@ -31,6 +31,16 @@ var (
 		Name: "dataset_ufs_total_size",
 		Help: "Total size of files in dataset",
 	}, []string{"dataset"})
+
+	datasetTimeToBound = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_time_to_bound_seconds",
+		Help: "Seconds between dataset creation and the dataset becoming bound",
+	}, []string{"dataset"})
+
+	datasetTimeToWarmUp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_time_to_warm_up_seconds",
+		Help: "Seconds between dataset creation and the cached percentage reaching the warm-up threshold",
+	}, []string{"dataset"})
 )
 
 var datasetMetricsMap map[string]*datasetMetrics
@@ -64,14 +74,24 @@ func (m *datasetMetrics) SetUFSFileNum(num float64) {
 	datasetUFSFileNum.With(m.labels).Set(num)
 }
 
+func (m *datasetMetrics) SetTimeToBound(seconds float64) {
+	datasetTimeToBound.With(m.labels).Set(seconds)
+}
+
+func (m *datasetMetrics) SetTimeToWarmUp(seconds float64) {
+	datasetTimeToWarmUp.With(m.labels).Set(seconds)
+}
+
 func (m *datasetMetrics) Forget() {
 	datasetUFSTotalSize.Delete(m.labels)
 	datasetUFSFileNum.Delete(m.labels)
+	datasetTimeToBound.Delete(m.labels)
+	datasetTimeToWarmUp.Delete(m.labels)
 
 	delete(datasetMetricsMap, m.datasetKey)
 }
 
 func init() {
-	metrics.Registry.MustRegister(datasetUFSFileNum, datasetUFSTotalSize)
+	metrics.Registry.MustRegister(datasetUFSFileNum, datasetUFSTotalSize, datasetTimeToBound, datasetTimeToWarmUp)
 	datasetMetricsMap = map[string]*datasetMetrics{}
 }
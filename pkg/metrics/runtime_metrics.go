@@ -17,6 +17,8 @@ limitations under the License.
 package metrics
 
 import (
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -33,6 +35,16 @@ var (
 		Name: "runtime_sync_healthcheck_error_total",
 		Help: "Total num of errors during runtime health check",
 	}, []string{"runtime_type", "runtime"})
+
+	fuseRestartBudgetExceededTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fuse_restart_budget_exceeded_total",
+		Help: "Total num of times a fuse pod exceeded its restart budget, by node",
+	}, []string{"runtime_type", "runtime", "node"})
+
+	runtimeFleetInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "runtime_fleet_info",
+		Help: "Inventory info of the runtime for fleet-wide upgrade planning: engine type, image version, scheduled worker replicas and health. Value is always 1.",
+	}, []string{"runtime_type", "runtime", "version", "replicas", "healthy"})
 )
 
 var runtimeMetricsMap map[string]*runtimeMetrics
@@ -43,6 +55,10 @@ type runtimeMetrics struct {
 	runtimeKey  string
 
 	labels prometheus.Labels
+
+	// fleetLabels remembers the label set of the last exported fleet info sample so a
+	// version or health change replaces the stale series instead of leaking it
+	fleetLabels prometheus.Labels
 }
 
 func GetRuntimeMetrics(runtimeType, runtimeNamespace, runtimeName string) *runtimeMetrics {
@@ -68,14 +84,44 @@ func (m *runtimeMetrics) HealthCheckErrorInc() {
 	runtimeHealthCheckErrorTotal.With(m.labels).Inc()
 }
 
+// FuseRestartBudgetExceededInc counts a fuse pod on the given node exceeding its
+// restart budget.
+func (m *runtimeMetrics) FuseRestartBudgetExceededInc(node string) {
+	fuseRestartBudgetExceededTotal.With(prometheus.Labels{
+		"runtime_type": strings.ToLower(m.runtimeType),
+		"runtime":      m.runtimeKey,
+		"node":         node,
+	}).Inc()
+}
+
+// SetFleetInfo exports the runtime's inventory sample, dropping the previous one when
+// its version, replicas or health changed.
+func (m *runtimeMetrics) SetFleetInfo(version string, replicas int64, healthy bool) {
+	labels := prometheus.Labels{
+		"runtime_type": strings.ToLower(m.runtimeType),
+		"runtime":      m.runtimeKey,
+		"version":      version,
+		"replicas":     strconv.FormatInt(replicas, 10),
+		"healthy":      strconv.FormatBool(healthy),
+	}
+	if m.fleetLabels != nil && !reflect.DeepEqual(m.fleetLabels, labels) {
+		runtimeFleetInfo.Delete(m.fleetLabels)
+	}
+	runtimeFleetInfo.With(labels).Set(1)
+	m.fleetLabels = labels
+}
+
 func (m *runtimeMetrics) Forget() {
 	runtimeSetupErrorTotal.Delete(m.labels)
 	runtimeHealthCheckErrorTotal.Delete(m.labels)
+	if m.fleetLabels != nil {
+		runtimeFleetInfo.Delete(m.fleetLabels)
+	}
 
 	delete(runtimeMetricsMap, m.runtimeKey)
 }
 
 func init() {
-	metrics.Registry.MustRegister(runtimeSetupErrorTotal, runtimeHealthCheckErrorTotal)
+	metrics.Registry.MustRegister(runtimeSetupErrorTotal, runtimeHealthCheckErrorTotal, fuseRestartBudgetExceededTotal, runtimeFleetInfo)
 	runtimeMetricsMap = map[string]*runtimeMetrics{}
 }
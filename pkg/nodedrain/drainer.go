@@ -0,0 +1,202 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nodedrain integrates Fluid with node maintenance. A node annotated with
+// "fluid.io/drain" is taken out of new cache placements immediately, and once no
+// application pod on the node mounts a cached dataset anymore the node is reported
+// ready via the "fluid.io/drain-status" annotation, at which point a standard
+// 'kubectl drain' can evict the remaining cache workers safely.
+package nodedrain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	// DrainCheckIntervalEnv sets how often annotated nodes are re-evaluated.
+	DrainCheckIntervalEnv = "NODE_DRAIN_CHECK_INTERVAL"
+
+	defaultInterval = 30 * time.Second
+)
+
+var _ manager.Runnable = &Drainer{}
+
+// Drainer watches for nodes annotated for drain, stops new cache placements on them and
+// reports readiness-for-drain back on the node.
+type Drainer struct {
+	client   client.Client
+	recorder record.EventRecorder
+	log      logr.Logger
+	interval time.Duration
+}
+
+func NewDrainer(client client.Client, recorder record.EventRecorder, log logr.Logger) *Drainer {
+	return &Drainer{
+		client:   client,
+		recorder: recorder,
+		log:      log,
+		interval: utils.GetDurationValueFromEnv(DrainCheckIntervalEnv, defaultInterval),
+	}
+}
+
+func (d *Drainer) Start(ctx context.Context) error {
+	d.log.Info("Starting the node drainer", "interval", d.interval)
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.drainOnce(ctx)
+		}
+	}
+}
+
+// drainOnce evaluates every node once, driving annotated nodes towards readiness and
+// resuming nodes whose drain annotation has been removed.
+func (d *Drainer) drainOnce(ctx context.Context) {
+	nodes := &corev1.NodeList{}
+	if err := d.client.List(ctx, nodes); err != nil {
+		d.log.Error(err, "failed to list nodes")
+		return
+	}
+
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		var err error
+		if len(node.Annotations[common.AnnotationNodeDrain]) != 0 {
+			err = d.drainNode(ctx, node)
+		} else if len(node.Labels[common.LabelNodeDraining]) != 0 || len(node.Annotations[common.AnnotationNodeDrainStatus]) != 0 {
+			err = d.resumeNode(ctx, node)
+		}
+		if err != nil {
+			d.log.Error(err, "failed to reconcile the drain state of the node", "node", node.Name)
+		}
+	}
+}
+
+func (d *Drainer) drainNode(ctx context.Context, node *corev1.Node) error {
+	// stop new cache placements right away, the scheduling code skips labeled nodes
+	if node.Labels[common.LabelNodeDraining] != common.True {
+		labelsToModify := common.LabelsToModify{}
+		labelsToModify.Add(common.LabelNodeDraining, common.True)
+		if _, err := utils.ChangeNodeLabelWithPatchMode(d.client, node, labelsToModify); err != nil {
+			return err
+		}
+	}
+
+	blockers, err := ListDrainBlockers(ctx, d.client, node.Name)
+	if err != nil {
+		return err
+	}
+
+	status := common.NodeDrainStatusReady
+	if len(blockers) != 0 {
+		status = common.NodeDrainStatusDraining
+	}
+	if node.Annotations[common.AnnotationNodeDrainStatus] == status {
+		return nil
+	}
+
+	if err := d.patchDrainStatus(ctx, node, status); err != nil {
+		return err
+	}
+	if status == common.NodeDrainStatusReady {
+		d.recorder.Eventf(node, corev1.EventTypeNormal, common.NodeDrainReady,
+			"No pod on node %s mounts a cached dataset anymore, the node is ready for 'kubectl drain'", node.Name)
+	} else {
+		d.log.Info("the node still has dataset consumers", "node", node.Name, "blockers", blockers)
+	}
+	return nil
+}
+
+// resumeNode puts a node back into regular cache placement after its drain annotation
+// has been removed.
+func (d *Drainer) resumeNode(ctx context.Context, node *corev1.Node) error {
+	if len(node.Labels[common.LabelNodeDraining]) != 0 {
+		labelsToModify := common.LabelsToModify{}
+		labelsToModify.Delete(common.LabelNodeDraining)
+		if _, err := utils.ChangeNodeLabelWithPatchMode(d.client, node, labelsToModify); err != nil {
+			return err
+		}
+	}
+	if len(node.Annotations[common.AnnotationNodeDrainStatus]) != 0 {
+		return d.patchDrainStatus(ctx, node, "")
+	}
+	return nil
+}
+
+func (d *Drainer) patchDrainStatus(ctx context.Context, node *corev1.Node, status string) error {
+	current := node.DeepCopy()
+	if len(status) == 0 {
+		delete(node.Annotations, common.AnnotationNodeDrainStatus)
+	} else {
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+		node.Annotations[common.AnnotationNodeDrainStatus] = status
+	}
+	return d.client.Patch(ctx, node, client.MergeFrom(current))
+}
+
+// ListDrainBlockers returns a description of every dataset that still has consumer pods
+// on the node. The node can not be drained safely before these pods are gone, since
+// evicting them tears down their fuse mounts.
+func ListDrainBlockers(ctx context.Context, c client.Client, nodeName string) ([]string, error) {
+	datasets := &datav1alpha1.DatasetList{}
+	if err := c.List(ctx, datasets); err != nil {
+		return nil, err
+	}
+
+	blockers := []string{}
+	for i := range datasets.Items {
+		dataset := &datasets.Items[i]
+		mountNodes, err := kubeclient.GetPvcMountNodes(c, dataset.Name, dataset.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if count, found := mountNodes[nodeName]; found && count > 0 {
+			blockers = append(blockers, fmt.Sprintf("%s/%s(%d consumer pods)", dataset.Namespace, dataset.Name, count))
+		}
+	}
+	sort.Strings(blockers)
+	return blockers, nil
+}
+
+// DescribeBlockers renders the blocker list for humans, e.g. in the CLI.
+func DescribeBlockers(blockers []string) string {
+	if len(blockers) == 0 {
+		return "none"
+	}
+	return strings.Join(blockers, ", ")
+}
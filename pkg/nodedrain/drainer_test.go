@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodedrain
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func newTestDrainer(t *testing.T, objects ...runtime.Object) *Drainer {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := datav1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the fluid scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the core scheme: %v", err)
+	}
+	return NewDrainer(fake.NewFakeClientWithScheme(s, objects...), record.NewFakeRecorder(10), fake.NullLogger())
+}
+
+func consumerPod(name, nodeName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			NodeName: nodeName,
+			Volumes: []corev1.Volume{
+				{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "hbase"},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+func TestDrainNodeLifecycle(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "node-1",
+			Annotations: map[string]string{common.AnnotationNodeDrain: common.NodeDrainRequested},
+		},
+	}
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+	}
+	pod := consumerPod("consumer", "node-1")
+
+	drainer := newTestDrainer(t, node, dataset, pod)
+	drainer.drainOnce(context.TODO())
+
+	updated := &corev1.Node{}
+	if err := drainer.client.Get(context.TODO(), client.ObjectKey{Name: "node-1"}, updated); err != nil {
+		t.Fatalf("fail to get the node: %v", err)
+	}
+	if updated.Labels[common.LabelNodeDraining] != common.True {
+		t.Errorf("expect the draining label to stop new placements, got %v", updated.Labels)
+	}
+	if updated.Annotations[common.AnnotationNodeDrainStatus] != common.NodeDrainStatusDraining {
+		t.Errorf("expect the node to report draining while a consumer pod remains, got %v", updated.Annotations)
+	}
+
+	// once the consumer pod is gone the node turns ready
+	if err := drainer.client.Delete(context.TODO(), pod); err != nil {
+		t.Fatalf("fail to delete the consumer pod: %v", err)
+	}
+	drainer.drainOnce(context.TODO())
+	if err := drainer.client.Get(context.TODO(), client.ObjectKey{Name: "node-1"}, updated); err != nil {
+		t.Fatalf("fail to get the node: %v", err)
+	}
+	if updated.Annotations[common.AnnotationNodeDrainStatus] != common.NodeDrainStatusReady {
+		t.Errorf("expect the node to report ready for drain, got %v", updated.Annotations)
+	}
+
+	// removing the drain annotation resumes regular placement
+	current := updated.DeepCopy()
+	delete(updated.Annotations, common.AnnotationNodeDrain)
+	if err := drainer.client.Patch(context.TODO(), updated, client.MergeFrom(current)); err != nil {
+		t.Fatalf("fail to remove the drain annotation: %v", err)
+	}
+	drainer.drainOnce(context.TODO())
+	if err := drainer.client.Get(context.TODO(), client.ObjectKey{Name: "node-1"}, updated); err != nil {
+		t.Fatalf("fail to get the node: %v", err)
+	}
+	if _, labeled := updated.Labels[common.LabelNodeDraining]; labeled {
+		t.Errorf("expect the draining label to be removed, got %v", updated.Labels)
+	}
+	if _, annotated := updated.Annotations[common.AnnotationNodeDrainStatus]; annotated {
+		t.Errorf("expect the drain status to be removed, got %v", updated.Annotations)
+	}
+}
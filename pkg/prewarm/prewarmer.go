@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prewarm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	// PrewarmIntervalEnv sets how often the prewarmer evaluates its predictions.
+	PrewarmIntervalEnv = "AUTO_PREWARM_INTERVAL"
+	// PrewarmLeadTimeEnv sets how long before the predicted demand the pre-warm load is created.
+	PrewarmLeadTimeEnv = "AUTO_PREWARM_LEAD_TIME"
+	// PrewarmMinObservationsEnv sets how many times a path must recur in the same
+	// weekday-hour slot before it is considered a learned pattern.
+	PrewarmMinObservationsEnv = "AUTO_PREWARM_MIN_OBSERVATIONS"
+
+	defaultInterval        = 10 * time.Minute
+	defaultLeadTime        = 1 * time.Hour
+	defaultMinObservations = 2
+)
+
+var _ manager.Runnable = &Prewarmer{}
+
+// Prewarmer learns per-dataset access patterns from the history of DataLoads and creates
+// targeted pre-warm DataLoads ahead of the predicted demand. A path that recurs in the
+// same weekday-hour slot across weeks counts as a hot prefix for that slot. Datasets
+// opt in with the "fluid.io/auto-prewarm" annotation, every decision is recorded as an
+// event on the dataset and as an annotation on the created DataLoad.
+type Prewarmer struct {
+	client          client.Client
+	recorder        record.EventRecorder
+	log             logr.Logger
+	interval        time.Duration
+	leadTime        time.Duration
+	minObservations int
+
+	// now is stubbed in tests
+	now func() time.Time
+}
+
+func NewPrewarmer(client client.Client, recorder record.EventRecorder, log logr.Logger) *Prewarmer {
+	prewarmer := &Prewarmer{
+		client:          client,
+		recorder:        recorder,
+		log:             log,
+		interval:        utils.GetDurationValueFromEnv(PrewarmIntervalEnv, defaultInterval),
+		leadTime:        utils.GetDurationValueFromEnv(PrewarmLeadTimeEnv, defaultLeadTime),
+		minObservations: defaultMinObservations,
+		now:             time.Now,
+	}
+	if value, found := utils.GetIntValueFormEnv(PrewarmMinObservationsEnv); found {
+		prewarmer.minObservations = value
+	}
+	return prewarmer
+}
+
+func (p *Prewarmer) Start(ctx context.Context) error {
+	p.log.Info("Starting the auto prewarmer", "interval", p.interval, "leadTime", p.leadTime)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			p.prewarmOnce(ctx)
+		}
+	}
+}
+
+// prewarmOnce evaluates all opted-in datasets once.
+func (p *Prewarmer) prewarmOnce(ctx context.Context) {
+	datasets := &datav1alpha1.DatasetList{}
+	if err := p.client.List(ctx, datasets); err != nil {
+		p.log.Error(err, "failed to list datasets")
+		return
+	}
+
+	for i := range datasets.Items {
+		dataset := &datasets.Items[i]
+		if dataset.Annotations[common.AnnotationAutoPrewarm] != "true" {
+			continue
+		}
+		if err := p.prewarmDataset(ctx, dataset); err != nil {
+			p.log.Error(err, "failed to prewarm the dataset", "dataset", dataset.Name, "namespace", dataset.Namespace)
+		}
+	}
+}
+
+func (p *Prewarmer) prewarmDataset(ctx context.Context, dataset *datav1alpha1.Dataset) error {
+	dataLoads := &datav1alpha1.DataLoadList{}
+	if err := p.client.List(ctx, dataLoads, &client.ListOptions{Namespace: dataset.Namespace}); err != nil {
+		return err
+	}
+
+	slotTime := p.now().Add(p.leadTime)
+	hotPaths := p.learnHotPaths(dataset, dataLoads, slotTime)
+	if len(hotPaths) == 0 {
+		return nil
+	}
+
+	name := fmt.Sprintf("%s-auto-prewarm-%s", dataset.Name, slotTime.Format("20060102-15"))
+	for _, dataLoad := range dataLoads.Items {
+		if dataLoad.Name == name {
+			// the decision for this slot is already taken
+			return nil
+		}
+	}
+
+	target := []datav1alpha1.TargetPath{}
+	for _, path := range hotPaths {
+		target = append(target, datav1alpha1.TargetPath{Path: path})
+	}
+	decision := fmt.Sprintf("paths %s recurred at least %d times on %ss around %02d:00",
+		strings.Join(hotPaths, ","), p.minObservations, slotTime.Weekday(), slotTime.Hour())
+
+	dataLoad := &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: dataset.Namespace,
+			Labels: map[string]string{
+				common.AnnotationAutoPrewarm: "true",
+			},
+			Annotations: map[string]string{
+				common.AnnotationPrewarmDecision: decision,
+			},
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{
+				Name:      dataset.Name,
+				Namespace: dataset.Namespace,
+			},
+			Target: target,
+		},
+	}
+	if err := p.client.Create(ctx, dataLoad); err != nil {
+		return err
+	}
+
+	p.log.Info("Scheduled a pre-warm DataLoad", "dataLoad", name, "dataset", dataset.Name, "decision", decision)
+	p.recorder.Eventf(dataset, v1.EventTypeNormal, common.AutoPrewarmScheduled,
+		"Scheduled pre-warm DataLoad %s ahead of predicted demand: %s", name, decision)
+	return nil
+}
+
+// learnHotPaths replays the DataLoad history of the dataset and returns the paths that
+// recurred in the weekday-hour slot of slotTime often enough to count as a pattern.
+// Pre-warm DataLoads created by the prewarmer itself are excluded to avoid feedback.
+func (p *Prewarmer) learnHotPaths(dataset *datav1alpha1.Dataset, dataLoads *datav1alpha1.DataLoadList, slotTime time.Time) []string {
+	occurrences := map[string]int{}
+	for _, dataLoad := range dataLoads.Items {
+		if dataLoad.Spec.Dataset.Name != dataset.Name {
+			continue
+		}
+		if dataLoad.Labels[common.AnnotationAutoPrewarm] == "true" {
+			continue
+		}
+		if dataLoad.Status.Phase == common.PhaseFailed {
+			continue
+		}
+		created := dataLoad.CreationTimestamp.Time
+		if created.Weekday() != slotTime.Weekday() || created.Hour() != slotTime.Hour() {
+			continue
+		}
+		for _, target := range dataLoad.Spec.Target {
+			occurrences[target.Path]++
+		}
+	}
+
+	hotPaths := []string{}
+	for path, count := range occurrences {
+		if count >= p.minObservations {
+			hotPaths = append(hotPaths, path)
+		}
+	}
+	sort.Strings(hotPaths)
+	return hotPaths
+}
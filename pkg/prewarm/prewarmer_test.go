@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prewarm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestPrewarmDataset(t *testing.T) {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "hbase",
+			Namespace: "fluid",
+			Annotations: map[string]string{
+				common.AnnotationAutoPrewarm: "true",
+			},
+		},
+	}
+
+	// two Mondays in a row the same path was loaded around 09:00
+	now := time.Date(2023, 10, 16, 8, 30, 0, 0, time.UTC) // a Monday
+	objects := []runtime.Object{dataset}
+	for week := 1; week <= 2; week++ {
+		objects = append(objects, &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("hbase-load-%d", week),
+				Namespace:         "fluid",
+				CreationTimestamp: metav1.NewTime(now.AddDate(0, 0, -7*week).Add(1 * time.Hour)),
+			},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "fluid"},
+				Target:  []datav1alpha1.TargetPath{{Path: "/reports"}, {Path: fmt.Sprintf("/tmp/%d", week)}},
+			},
+		})
+	}
+
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	testClient := fake.NewFakeClientWithScheme(s, objects...)
+	prewarmer := NewPrewarmer(testClient, record.NewFakeRecorder(10), fake.NullLogger())
+	prewarmer.now = func() time.Time { return now }
+
+	prewarmer.prewarmOnce(context.TODO())
+
+	dataLoads := &datav1alpha1.DataLoadList{}
+	if err := testClient.List(context.TODO(), dataLoads); err != nil {
+		t.Fatalf("fail to list dataloads: %v", err)
+	}
+	var created *datav1alpha1.DataLoad
+	for i := range dataLoads.Items {
+		if dataLoads.Items[i].Labels[common.AnnotationAutoPrewarm] == "true" {
+			created = &dataLoads.Items[i]
+		}
+	}
+	if created == nil {
+		t.Fatalf("expect a pre-warm DataLoad to be created, got %d dataloads", len(dataLoads.Items))
+	}
+	// only the recurring path counts as a learned pattern, the one-off paths do not
+	if len(created.Spec.Target) != 1 || created.Spec.Target[0].Path != "/reports" {
+		t.Errorf("expect the pre-warm load to target /reports only, got %v", created.Spec.Target)
+	}
+	if len(created.Annotations[common.AnnotationPrewarmDecision]) == 0 {
+		t.Errorf("expect the decision to be recorded on the pre-warm load")
+	}
+
+	// a second evaluation in the same slot must not schedule a duplicate
+	prewarmer.prewarmOnce(context.TODO())
+	if err := testClient.List(context.TODO(), dataLoads); err != nil {
+		t.Fatalf("fail to list dataloads: %v", err)
+	}
+	if len(dataLoads.Items) != 3 {
+		t.Errorf("expect no duplicate pre-warm load, got %d dataloads", len(dataLoads.Items))
+	}
+}
@@ -83,6 +83,25 @@ func InjectCacheDirEnabled(infos map[string]string) (match bool) {
 	return enabled(infos, common.InjectCacheDir)
 }
 
+// ShareMountEnabled checks whether the fuse sidecar mount should be shared with every
+// app container of the pod instead of only the containers declaring the dataset PVC
+func ShareMountEnabled(infos map[string]string) (match bool) {
+	return ServerlessEnabled(infos) && enabled(infos, common.InjectShareMount)
+}
+
+// CacheBypassOf returns the cache bypass mode a pod requests through the
+// fluid.io/cache-bypass annotation, either 'write' or 'all'. Anything else,
+// including an absent annotation, means no bypass.
+func CacheBypassOf(infos map[string]string) string {
+	switch infos[common.AnnotationCacheBypass] {
+	case common.CacheBypassWrite:
+		return common.CacheBypassWrite
+	case common.CacheBypassAll:
+		return common.CacheBypassAll
+	}
+	return ""
+}
+
 func AppControllerDisabled(info map[string]string) (match bool) {
 	return matchedKey(info, disableApplicationController)
 }
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
@@ -204,3 +205,22 @@ func (u *UFSToUpdate) AddMountPaths(mountPaths []string) {
 		}
 	}
 }
+
+// GetSharedMetadataOfDataset returns the dataset's shared labels and annotations which
+// should be propagated to the child resources created for it. Keys under fluid's
+// reserved prefix are dropped so that shared metadata can never shadow fluid's own
+// labels and annotations.
+func GetSharedMetadataOfDataset(dataset datav1alpha1.Dataset) (labels, annotations map[string]string) {
+	return filterReservedMetadata(dataset.Spec.SharedLabels), filterReservedMetadata(dataset.Spec.SharedAnnotations)
+}
+
+func filterReservedMetadata(metadata map[string]string) map[string]string {
+	filtered := map[string]string{}
+	for key, value := range metadata {
+		if strings.HasPrefix(key, common.LabelAnnotationPrefix) {
+			continue
+		}
+		filtered[key] = value
+	}
+	return filtered
+}
@@ -69,8 +69,15 @@ func CanbeAssigned(runtimeInfo base.RuntimeInfoInterface, node v1.Node) bool {
 	// if e.alreadyAssignedByFluid(node) {
 	// 	return false
 	// }
-	label := utils.GetExclusiveKey()
 	log := rootLog.WithValues("runtime", runtimeInfo.GetName(), "namespace", runtimeInfo.GetNamespace())
+
+	// a node being drained for maintenance takes no new cache placements
+	if node.Labels[common.LabelNodeDraining] == common.True {
+		log.Info("node is being drained for maintenance, can not be assigned", "node", node.Name)
+		return false
+	}
+
+	label := utils.GetExclusiveKey()
 	value, cannotBeAssigned := node.Labels[label]
 	if cannotBeAssigned {
 		log.Info("node ", node.Name, "is exclusive and already be assigned, can not be assigned",
@@ -0,0 +1,155 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"sort"
+	"strings"
+
+	units "github.com/docker/go-units"
+	corev1 "k8s.io/api/core/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tieredstore"
+)
+
+const (
+	// BinpackPackingStrategy fills the already most committed nodes first, consolidating
+	// shared cache nodes and keeping the rest free
+	BinpackPackingStrategy = "binpack"
+
+	// SpreadPackingStrategy prefers the least committed nodes, spreading cache load
+	SpreadPackingStrategy = "spread"
+)
+
+// nodeCacheCommitment is the cache capacity in bytes that datasets have already been
+// promised on a node, read back from the capacity labels LabelCacheNode leaves behind
+type nodeCacheCommitment struct {
+	memoryBytes int64
+	diskBytes   int64
+}
+
+func (c nodeCacheCommitment) totalBytes() int64 {
+	return c.memoryBytes + c.diskBytes
+}
+
+// PlanPackedPlacement packs a shared-placement dataset over the candidate nodes: nodes
+// whose memory or disk budget the dataset's tier quotas would overcommit - counting what
+// other datasets already committed there - are filtered out, and the remaining nodes are
+// ordered by the packing strategy. This replaces independent greedy placement that only
+// looks at each dataset in isolation and overcommits disks on shared cache nodes.
+func PlanPackedPlacement(nodes []corev1.Node, runtimeInfo base.RuntimeInfoInterface, strategy string) []corev1.Node {
+	log := rootLog.WithValues("runtime", runtimeInfo.GetName(), "namespace", runtimeInfo.GetNamespace())
+
+	storageMap := tieredstore.GetLevelStorageMap(runtimeInfo)
+	var memoryRequired, diskRequired int64
+	if quantity, found := storageMap[common.MemoryCacheStore]; found {
+		memoryRequired = quantity.Value()
+	}
+	if quantity, found := storageMap[common.DiskCacheStore]; found {
+		diskRequired = quantity.Value()
+	}
+
+	packable := make([]corev1.Node, 0, len(nodes))
+	commitments := map[string]nodeCacheCommitment{}
+	for _, node := range nodes {
+		committed := committedCacheOnNode(node, runtimeInfo)
+		commitments[node.Name] = committed
+
+		if memoryRequired > 0 {
+			if budget := node.Status.Allocatable.Memory().Value(); committed.memoryBytes+memoryRequired > budget {
+				log.Info("Node is skipped by the packing planner, its memory budget would be overcommitted",
+					"node", node.Name, "committed", committed.memoryBytes, "required", memoryRequired, "budget", budget)
+				continue
+			}
+		}
+		if diskRequired > 0 {
+			if budget := nodeDiskBudget(node); budget > 0 && committed.diskBytes+diskRequired > budget {
+				log.Info("Node is skipped by the packing planner, its disk budget would be overcommitted",
+					"node", node.Name, "committed", committed.diskBytes, "required", diskRequired, "budget", budget)
+				continue
+			}
+		}
+		packable = append(packable, node)
+	}
+
+	// order stably so equally committed nodes keep their original priority, e.g. the
+	// pvc-mount-first ordering of the fuse global mode
+	sort.SliceStable(packable, func(i, j int) bool {
+		if strategy == SpreadPackingStrategy {
+			return commitments[packable[i].Name].totalBytes() < commitments[packable[j].Name].totalBytes()
+		}
+		return commitments[packable[i].Name].totalBytes() > commitments[packable[j].Name].totalBytes()
+	})
+	return packable
+}
+
+// committedCacheOnNode sums the cache capacity other datasets already committed on the
+// node from their 'fluid.io/s-h-{type}-m-{ns}-{name}' (memory) and '...-d-...' (disk)
+// capacity labels, leaving out the reconciling runtime's own labels
+func committedCacheOnNode(node corev1.Node, runtimeInfo base.RuntimeInfoInterface) (committed nodeCacheCommitment) {
+	ownLabels := map[string]bool{
+		runtimeInfo.GetLabelNameForMemory(): true,
+		runtimeInfo.GetLabelNameForDisk():   true,
+		runtimeInfo.GetLabelNameForTotal():  true,
+	}
+
+	capacityLabelPrefix := common.LabelAnnotationStorageCapacityPrefix + string(common.HumanReadType)
+	for key, value := range node.Labels {
+		if !strings.HasPrefix(key, capacityLabelPrefix) || ownLabels[key] {
+			continue
+		}
+		bytes, err := units.RAMInBytes(value)
+		if err != nil {
+			continue
+		}
+		switch {
+		case strings.Contains(key, "-"+string(common.MemoryStorageType)):
+			committed.memoryBytes += bytes
+		case strings.Contains(key, "-"+string(common.DiskStorageType)):
+			committed.diskBytes += bytes
+		}
+	}
+	return
+}
+
+// nodeDiskBudget derives the node's disk budget for caching: the capacity of the storage
+// media discovered on the node when annotated, the allocatable ephemeral storage
+// otherwise. Zero means the budget is unknown and disk packing is not enforced.
+func nodeDiskBudget(node corev1.Node) (budget int64) {
+	for key, value := range node.Annotations {
+		if strings.HasPrefix(key, common.LabelAnnotationStorageMediaPrefix) &&
+			strings.HasSuffix(key, common.AnnotationStorageMediaCapacitySuffix) {
+			if bytes, err := units.RAMInBytes(value); err == nil {
+				budget += bytes
+			}
+		}
+	}
+	if budget > 0 {
+		return budget
+	}
+	return node.Status.Allocatable.StorageEphemeral().Value()
+}
+
+// packingStrategyOf reads the dataset's packing strategy, defaulting to binpack
+func packingStrategyOf(dataset *datav1alpha1.Dataset) string {
+	if dataset.Annotations[common.AnnotationPackingStrategy] == SpreadPackingStrategy {
+		return SpreadPackingStrategy
+	}
+	return BinpackPackingStrategy
+}
@@ -0,0 +1,114 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecycle
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPlanPackedPlacement(t *testing.T) {
+	tireStore := datav1alpha1.TieredStore{
+		Levels: []datav1alpha1.Level{
+			{
+				MediumType: common.SSD,
+				Quota:      resource.NewQuantity(10*1024*1024*1024, resource.BinarySI),
+			},
+		},
+	}
+	runtimeInfo, err := base.BuildRuntimeInfo("spark", "fluid", "alluxio", tireStore)
+	if err != nil {
+		t.Errorf("fail to create the runtimeInfo with error %v", err)
+	}
+	runtimeInfo.SetupWithDataset(&datav1alpha1.Dataset{
+		Spec: datav1alpha1.DatasetSpec{PlacementMode: datav1alpha1.ShareMode},
+	})
+
+	ephemeralStorage := resource.MustParse("100Gi")
+	nodes := []v1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "empty-node",
+			},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{v1.ResourceEphemeralStorage: ephemeralStorage},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "committed-node",
+				Labels: map[string]string{
+					// another shared dataset already committed 60GiB of disk cache here
+					"fluid.io/s-h-alluxio-d-fluid-hbase": "60.00GiB",
+					"fluid.io/s-h-alluxio-t-fluid-hbase": "60.00GiB",
+				},
+			},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{v1.ResourceEphemeralStorage: ephemeralStorage},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "overcommitted-node",
+				Labels: map[string]string{
+					"fluid.io/s-h-alluxio-d-fluid-hbase": "95.00GiB",
+					"fluid.io/s-h-alluxio-t-fluid-hbase": "95.00GiB",
+				},
+			},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{v1.ResourceEphemeralStorage: ephemeralStorage},
+			},
+		},
+	}
+
+	packed := PlanPackedPlacement(nodes, runtimeInfo, BinpackPackingStrategy)
+	if len(packed) != 2 {
+		t.Fatalf("expect the overcommitted node to be filtered out, got %v nodes", len(packed))
+	}
+	if packed[0].Name != "committed-node" || packed[1].Name != "empty-node" {
+		t.Errorf("expect binpack to fill the committed node first, got order %s, %s", packed[0].Name, packed[1].Name)
+	}
+
+	spread := PlanPackedPlacement(nodes, runtimeInfo, SpreadPackingStrategy)
+	if len(spread) != 2 {
+		t.Fatalf("expect the overcommitted node to be filtered out, got %v nodes", len(spread))
+	}
+	if spread[0].Name != "empty-node" || spread[1].Name != "committed-node" {
+		t.Errorf("expect spread to prefer the least committed node, got order %s, %s", spread[0].Name, spread[1].Name)
+	}
+}
+
+func TestPackingStrategyOf(t *testing.T) {
+	defaulted := packingStrategyOf(&datav1alpha1.Dataset{})
+	if defaulted != BinpackPackingStrategy {
+		t.Errorf("expect the default packing strategy to be %s, got %s", BinpackPackingStrategy, defaulted)
+	}
+
+	spread := packingStrategyOf(&datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{common.AnnotationPackingStrategy: SpreadPackingStrategy},
+		},
+	})
+	if spread != SpreadPackingStrategy {
+		t.Errorf("expect the packing strategy to be %s, got %s", SpreadPackingStrategy, spread)
+	}
+}
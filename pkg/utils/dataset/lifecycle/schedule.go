@@ -99,6 +99,13 @@ func AssignDatasetToNodes(runtimeInfo base.RuntimeInfoInterface,
 		nodes = nodeList.Items
 	}
 
+	// Pack shared-placement datasets over the candidates respecting the cache capacity
+	// other datasets already committed on each node, so co-existing datasets don't
+	// overcommit memory or disks. Exclusive datasets take whole nodes anyway.
+	if !runtimeInfo.IsExclusive() {
+		nodes = PlanPackedPlacement(nodes, runtimeInfo, packingStrategyOf(dataset))
+	}
+
 	// 4. filter candidate nodes
 	for _, node := range nodes {
 
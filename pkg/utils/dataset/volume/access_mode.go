@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SyncAccessModes transitions a bound dataset's volume between read-only and read-write
+// when dataset.spec.accessModes changed after the volume was created, instead of forcing
+// the user to recreate the dataset. The workflow is deliberately conservative: the
+// transition is refused while any consumer pod still mounts the volume, then the PV's
+// access modes are updated in place and the PVC (whose access modes are immutable) is
+// recreated against the same PV. New consumers pick up the new mode on mount; running
+// FUSE mounts are refreshed through the recovery channel.
+func SyncAccessModes(client client.Client, runtime base.RuntimeInfoInterface, log logr.Logger) (updated bool, err error) {
+	accessModes, err := utils.GetAccessModesOfDataset(client, runtime.GetName(), runtime.GetNamespace())
+	if err != nil {
+		return false, err
+	}
+
+	pvName := runtime.GetPersistentVolumeName()
+	pv, err := kubeclient.GetPersistentVolume(client, pvName)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			// nothing to transition before the volume exists
+			return false, nil
+		}
+		return false, err
+	}
+
+	if reflect.DeepEqual(pv.Spec.AccessModes, accessModes) {
+		return false, nil
+	}
+
+	// 1. validate consumers: a mode flip under a running consumer gives it a stale view
+	pods, err := kubeclient.GetPvcMountPods(client, runtime.GetName(), runtime.GetNamespace())
+	if err != nil {
+		return false, err
+	}
+	if len(pods) > 0 {
+		return false, fmt.Errorf("can't transition the access modes of dataset %s in namespace %s to %v: %d consumer pod(s) still mount it, drain them first",
+			runtime.GetName(), runtime.GetNamespace(), accessModes, len(pods))
+	}
+
+	// 2. update the PV access modes in place
+	pvToUpdate := pv.DeepCopy()
+	pvToUpdate.Spec.AccessModes = accessModes
+	if err = client.Update(context.TODO(), pvToUpdate); err != nil {
+		return false, err
+	}
+	log.Info("updated the access modes of the persistent volume", "pv", pvName, "accessModes", accessModes)
+
+	// 3. recreate the PVC since its access modes are immutable. It rebinds to the same
+	// PV through the label selector, no data is touched.
+	found, err := kubeclient.IsPersistentVolumeClaimExist(client, runtime.GetName(), runtime.GetNamespace(), map[string]string{})
+	if err != nil {
+		return false, err
+	}
+	if found {
+		if err = kubeclient.DeletePersistentVolumeClaim(client, runtime.GetName(), runtime.GetNamespace()); err != nil {
+			return false, err
+		}
+	}
+	if err = CreatePersistentVolumeClaimForRuntime(client, runtime, log); err != nil {
+		return false, err
+	}
+	log.Info("recreated the persistent volume claim with the new access modes",
+		"pvc", runtime.GetName(), "accessModes", accessModes)
+
+	return true, nil
+}
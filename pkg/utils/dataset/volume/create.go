@@ -134,6 +134,13 @@ func CreatePersistentVolumeForRuntime(client client.Client,
 				},
 			}
 		}
+		sharedLabels, sharedAnnotations, err := getSharedMetadata(client, runtime)
+		if err != nil {
+			return err
+		}
+		pv.Labels = utils.UnionMapsWithOverride(sharedLabels, pv.Labels)
+		pv.Annotations = utils.UnionMapsWithOverride(sharedAnnotations, pv.Annotations)
+
 		metadataList := runtime.GetMetadataList()
 		for i := range metadataList {
 			if selector := metadataList[i].Selector; selector.Group != v1.GroupName || selector.Kind != "PersistentVolume" {
@@ -193,6 +200,13 @@ func CreatePersistentVolumeClaimForRuntime(client client.Client,
 				},
 			},
 		}
+		sharedLabels, sharedAnnotations, err := getSharedMetadata(client, runtime)
+		if err != nil {
+			return err
+		}
+		pvc.Labels = utils.UnionMapsWithOverride(sharedLabels, pvc.Labels)
+		pvc.Annotations = utils.UnionMapsWithOverride(sharedAnnotations, pvc.Annotations)
+
 		metadataList := runtime.GetMetadataList()
 		for i := range metadataList {
 			if selector := metadataList[i].Selector; selector.Group != v1.GroupName || selector.Kind != "PersistentVolumeClaim" {
@@ -210,3 +224,18 @@ func CreatePersistentVolumeClaimForRuntime(client client.Client,
 
 	return err
 }
+
+// getSharedMetadata fetches the dataset's shared labels and annotations which are
+// propagated to the volumes created for it. A missing dataset yields empty metadata
+// instead of an error to keep volume creation working in tests and edge cases.
+func getSharedMetadata(client client.Client, runtime base.RuntimeInfoInterface) (labels, annotations map[string]string, err error) {
+	dataset, err := utils.GetDataset(client, runtime.GetName(), runtime.GetNamespace())
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return map[string]string{}, map[string]string{}, nil
+		}
+		return nil, nil, err
+	}
+	labels, annotations = utils.GetSharedMetadataOfDataset(*dataset)
+	return
+}
@@ -104,6 +104,8 @@ func ParseInitImage(image, tag, imagePullPolicy, envName string) (string, string
 		}
 	}
 
+	image, tag = RewriteImageRepoTag(image, tag)
+
 	return image, tag, imagePullPolicy
 }
 
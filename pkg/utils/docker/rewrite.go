@@ -0,0 +1,96 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ImageRewriteRulesEnv carries the operator-wide image rewrite rules as JSON, typically
+// mounted from a ConfigMap into every fluid controller. The rules map the default
+// images (runtime, fuse, init, data-mover) to a private registry prefix and optionally
+// pin them to digests, so air-gapped deployments configure the mirror in one place
+// instead of overriding each image env individually.
+const ImageRewriteRulesEnv = "FLUID_IMAGE_REWRITE_RULES"
+
+// ImageRewriteRules declares how resolved images are rewritten before they land in pod
+// specs and helm values.
+type ImageRewriteRules struct {
+	// RegistryPrefix replaces the registry of every resolved image, keeping the
+	// repository path, e.g. 'registry.example.com/mirror' turns
+	// 'registry.cn-hangzhou.aliyuncs.com/fluid/init-users' into
+	// 'registry.example.com/mirror/fluid/init-users'
+	RegistryPrefix string `json:"registryPrefix,omitempty"`
+
+	// Digests pins repositories to digests, keyed by the repository either with or
+	// without its registry, e.g. 'fluid/init-users': 'sha256:...'. The digest is
+	// appended to the image reference so the pulled content no longer depends on
+	// what the mirror serves under the tag
+	Digests map[string]string `json:"digests,omitempty"`
+}
+
+// loadImageRewriteRules reads the rewrite rules from the environment. Unset or
+// malformed rules disable rewriting.
+func loadImageRewriteRules() (rules ImageRewriteRules) {
+	value, existed := os.LookupEnv(ImageRewriteRulesEnv)
+	if !existed || len(value) == 0 {
+		return
+	}
+	if err := json.Unmarshal([]byte(value), &rules); err != nil {
+		return ImageRewriteRules{}
+	}
+	return
+}
+
+// RewriteImageRepoTag applies the operator-wide rewrite rules to an image resolved as a
+// separate repository and tag, the form the engines' helm values use. A pinned digest is
+// carried in the returned tag ('{tag}@{digest}'), which joins into a valid canonical
+// reference when templates concatenate '{repo}:{tag}'.
+func RewriteImageRepoTag(repo, tag string) (string, string) {
+	rules := loadImageRewriteRules()
+	if len(rules.RegistryPrefix) == 0 && len(rules.Digests) == 0 {
+		return repo, tag
+	}
+
+	if digest, found := digestFor(rules, repo); found && !strings.Contains(tag, "@") {
+		tag = fmt.Sprintf("%s@%s", tag, digest)
+	}
+
+	if len(rules.RegistryPrefix) > 0 {
+		_, path := splitImageRegistry(repo)
+		repo = strings.TrimSuffix(rules.RegistryPrefix, "/") + "/" + path
+	}
+
+	return repo, tag
+}
+
+// RewriteImage applies the operator-wide rewrite rules to a full image reference.
+func RewriteImage(image string) string {
+	repo, tag := ParseDockerImage(image)
+	repo, tag = RewriteImageRepoTag(repo, tag)
+	return fmt.Sprintf("%s:%s", repo, tag)
+}
+
+// digestFor looks the repository up in the digest pins, both as given and without its
+// registry, so pins keep working regardless of which form the rules use.
+func digestFor(rules ImageRewriteRules, repo string) (digest string, found bool) {
+	if digest, found = rules.Digests[repo]; found {
+		return
+	}
+	_, path := splitImageRegistry(repo)
+	digest, found = rules.Digests[path]
+	return
+}
+
+// splitImageRegistry splits a repository into its registry and path. Following the
+// docker reference convention, the first path component is a registry only if it
+// contains a dot or a port, or is 'localhost'.
+func splitImageRegistry(repo string) (registry, path string) {
+	parts := strings.SplitN(repo, "/", 2)
+	if len(parts) == 2 &&
+		(strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0], parts[1]
+	}
+	return "", repo
+}
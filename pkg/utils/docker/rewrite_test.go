@@ -0,0 +1,68 @@
+package docker
+
+import (
+	"testing"
+)
+
+func TestRewriteImageRepoTag(t *testing.T) {
+	t.Setenv(ImageRewriteRulesEnv, `{
+		"registryPrefix": "registry.example.com/mirror",
+		"digests": {
+			"fluid/init-users": "sha256:0123456789abcdef"
+		}
+	}`)
+
+	testCase := []struct {
+		repo     string
+		tag      string
+		wantRepo string
+		wantTag  string
+	}{
+		{
+			repo:     "registry.cn-hangzhou.aliyuncs.com/fluid/init-users",
+			tag:      "v0.3.0",
+			wantRepo: "registry.example.com/mirror/fluid/init-users",
+			wantTag:  "v0.3.0@sha256:0123456789abcdef",
+		},
+		{
+			repo:     "fluid/init-users",
+			tag:      "v0.3.0",
+			wantRepo: "registry.example.com/mirror/fluid/init-users",
+			wantTag:  "v0.3.0@sha256:0123456789abcdef",
+		},
+		{
+			repo:     "alluxio/alluxio-dev",
+			tag:      "2.9.0",
+			wantRepo: "registry.example.com/mirror/alluxio/alluxio-dev",
+			wantTag:  "2.9.0",
+		},
+	}
+
+	for _, test := range testCase {
+		repo, tag := RewriteImageRepoTag(test.repo, test.tag)
+		if repo != test.wantRepo || tag != test.wantTag {
+			t.Errorf("expected %v:%v, got %v:%v", test.wantRepo, test.wantTag, repo, tag)
+		}
+	}
+}
+
+func TestRewriteImageRepoTagWithoutRules(t *testing.T) {
+	repo, tag := RewriteImageRepoTag("alluxio/alluxio-dev", "2.9.0")
+	if repo != "alluxio/alluxio-dev" || tag != "2.9.0" {
+		t.Errorf("expected the image to be unchanged without rules, got %v:%v", repo, tag)
+	}
+
+	t.Setenv(ImageRewriteRulesEnv, "not a json")
+	repo, tag = RewriteImageRepoTag("alluxio/alluxio-dev", "2.9.0")
+	if repo != "alluxio/alluxio-dev" || tag != "2.9.0" {
+		t.Errorf("expected malformed rules to disable rewriting, got %v:%v", repo, tag)
+	}
+}
+
+func TestRewriteImage(t *testing.T) {
+	t.Setenv(ImageRewriteRulesEnv, `{"registryPrefix": "localhost:5000"}`)
+
+	if result := RewriteImage("fluid/alluxio-fuse:v2.9.0"); result != "localhost:5000/fluid/alluxio-fuse:v2.9.0" {
+		t.Errorf("expected localhost:5000/fluid/alluxio-fuse:v2.9.0, got %v", result)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// renderedValueFile records the last value file rendered for a release.
+type renderedValueFile struct {
+	checksum string
+	fileName string
+}
+
+var (
+	valueFileCacheMutex sync.Mutex
+	valueFileCache      = map[string]renderedValueFile{}
+)
+
+// GenerateValueFileWithCache works like GenerateValueFile but remembers the last value
+// file rendered for the release. When the serialized values are unchanged and the file
+// is still on disk, the file is reused instead of rendered again, which saves repeated
+// serialization and temp files when many datasets are reconciled at once.
+// It reports whether the cached file was reused.
+func GenerateValueFileWithCache(name, namespace string, values interface{}) (valueFileName string, reused bool, err error) {
+	data, err := yaml.Marshal(values)
+	if err != nil {
+		return "", false, err
+	}
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	key := fmt.Sprintf("%s/%s", namespace, name)
+
+	valueFileCacheMutex.Lock()
+	defer valueFileCacheMutex.Unlock()
+
+	if cached, found := valueFileCache[key]; found && cached.checksum == checksum {
+		if _, statErr := os.Stat(cached.fileName); statErr == nil {
+			log.V(1).Info("Reuse the cached values file", "fileName", cached.fileName, "key", key)
+			return cached.fileName, true, nil
+		}
+	}
+
+	valueFile, err := os.CreateTemp(os.TempDir(), fmt.Sprintf("%s-%s-values.yaml", namespace, name))
+	if err != nil {
+		log.Error(err, "Failed to create tmp file for values")
+		return "", false, err
+	}
+	valueFileName = valueFile.Name()
+	if err = os.WriteFile(valueFileName, data, 0400); err != nil {
+		return "", false, err
+	}
+	log.V(1).Info("Save the values file", "fileName", valueFileName, "key", key)
+
+	valueFileCache[key] = renderedValueFile{
+		checksum: checksum,
+		fileName: valueFileName,
+	}
+	return valueFileName, false, nil
+}
+
+// ForgetValueFile drops the cached value file of a release, e.g. after the release has
+// been uninstalled.
+func ForgetValueFile(name, namespace string) {
+	valueFileCacheMutex.Lock()
+	defer valueFileCacheMutex.Unlock()
+	delete(valueFileCache, fmt.Sprintf("%s/%s", namespace, name))
+}
@@ -0,0 +1,86 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"os"
+	"strconv"
+	"sync"
+)
+
+// defaultExecParallelism bounds how many helm processes may run at the same time unless
+// overridden by the HELM_EXEC_PARALLELISM env variable.
+const defaultExecParallelism = 8
+
+var execSlots chan struct{}
+
+func init() {
+	parallelism := defaultExecParallelism
+	if value, found := os.LookupEnv("HELM_EXEC_PARALLELISM"); found {
+		if parsed, err := strconv.Atoi(value); err == nil && parsed > 0 {
+			parallelism = parsed
+		}
+	}
+	execSlots = make(chan struct{}, parallelism)
+}
+
+// acquireExecSlot blocks until a helm process slot is free. Every acquired slot must be
+// released with releaseExecSlot.
+func acquireExecSlot() {
+	execSlots <- struct{}{}
+}
+
+func releaseExecSlot() {
+	<-execSlots
+}
+
+// InstallRequest describes one release to be installed by InstallReleases.
+type InstallRequest struct {
+	Name      string
+	Namespace string
+	ValueFile string
+	ChartName string
+}
+
+// InstallReleases installs the given releases concurrently with at most workers
+// in flight, defaulting to the helm process parallelism when workers is not positive.
+// The returned slice holds the per-request results aligned by index.
+func InstallReleases(requests []InstallRequest, workers int) (errs []error) {
+	if workers <= 0 {
+		workers = cap(execSlots)
+	}
+	errs = make([]error, len(requests))
+
+	var wg sync.WaitGroup
+	jobs := make(chan int)
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				request := requests[i]
+				errs[i] = InstallRelease(request.Name, request.Namespace, request.ValueFile, request.ChartName)
+			}
+		}()
+	}
+	for i := range requests {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
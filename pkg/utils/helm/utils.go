@@ -53,7 +53,9 @@ func InstallRelease(name string, namespace string, valueFile string, chartName s
 	// }
 
 	// return syscall.Exec(cmd, args, env)
-	// 5. execute the command
+	// 5. execute the command under a bounded number of concurrent helm processes
+	acquireExecSlot()
+	defer releaseExecSlot()
 	cmd := exec.Command(binary, args...)
 	log.Info("Exec", "command", cmd.String())
 	// cmd.Env = env
@@ -146,8 +148,11 @@ func DeleteRelease(name, namespace string) error {
 	}
 
 	args := []string{"uninstall", name, "-n", namespace}
+	acquireExecSlot()
+	defer releaseExecSlot()
 	cmd := exec.Command(binary, args...)
 	log.Info("Exec", "command", cmd.String())
+	ForgetValueFile(name, namespace)
 	// env := os.Environ()
 	// if types.KubeConfig != "" {
 	// 	env = append(env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loglevel
+
+import (
+	"flag"
+	"os"
+
+	"github.com/go-logr/logr"
+)
+
+// glogFlagNames are the glog verbosity flags that can be hot-reconfigured. "v" raises
+// the global verbosity and "vmodule" raises it per source file, e.g.
+// "vmodule=nodeserver*=5" for the CSI node server only.
+var glogFlagNames = []string{"v", "vmodule"}
+
+// WatchGlogConfigFile hot-applies the "v" and "vmodule" entries of the config file to
+// the glog flags, for components logging via glog such as the CSI plugin. Entries
+// removed from the file roll back to the values from the command line, an empty path
+// disables hot reconfiguration.
+func WatchGlogConfigFile(path string, log logr.Logger) {
+	if len(path) == 0 {
+		return
+	}
+
+	defaults := map[string]string{}
+	for _, name := range glogFlagNames {
+		if f := flag.Lookup(name); f != nil {
+			defaults[name] = f.Value.String()
+		}
+	}
+
+	lastApplied := ""
+	apply := func() {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			content = nil
+		}
+		if string(content) == lastApplied {
+			return
+		}
+		lastApplied = string(content)
+
+		entries := parseEntries(content, log)
+		for _, name := range glogFlagNames {
+			if _, registered := defaults[name]; !registered {
+				continue
+			}
+			value, configured := entries[name]
+			if !configured {
+				value = defaults[name]
+			}
+			if err := flag.Set(name, value); err != nil {
+				log.Error(err, "fail to apply the glog verbosity flag", "flag", name, "value", value)
+				continue
+			}
+			log.Info("applied dynamic glog verbosity", "flag", name, "value", value)
+		}
+	}
+
+	apply()
+	go watchFile(path, log, apply)
+}
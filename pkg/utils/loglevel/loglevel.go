@@ -0,0 +1,272 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package loglevel reconfigures log verbosity at runtime from a config file, typically a
+// mounted ConfigMap, so verbosity can be raised for a specific module during incident
+// debugging without restarting the component. The file holds one "<module>=<level>" pair
+// per line, where "default" sets the global level, any other key matches a dotted logger
+// name prefix (e.g. "datasetctl.Dataset"), and a level is a zap level name like "debug"
+// or a signed number where -n corresponds to logr V(n).
+package loglevel
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-logr/logr"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	defaultModuleKey = "default"
+
+	// reloadInterval is the fallback polling interval, also a safety net behind the
+	// file watch since ConfigMap updates surface as symlink swaps in the mount dir
+	reloadInterval = 30 * time.Second
+)
+
+// Controller holds the effective log levels for a zap-based component and reloads them
+// when the watched config file changes. It implements zapcore.LevelEnabler so it can be
+// set as the logger's level, and its WrapCore filter applies the per-module levels.
+type Controller struct {
+	log logr.Logger
+
+	// fallbackLevel is the level from the command line flags, restored when the config
+	// file disappears or holds no default
+	fallbackLevel zapcore.Level
+
+	mu           sync.RWMutex
+	defaultLevel zapcore.Level
+	moduleLevels map[string]zapcore.Level
+	// minLevel is the most verbose level any module is configured at, used as the
+	// logger's coarse gate before the per-module filter decides
+	minLevel zapcore.Level
+
+	lastApplied string
+}
+
+// NewController builds a controller that starts at the given default level and keeps it
+// until WatchConfigFile loads a config.
+func NewController(defaultLevel zapcore.Level, log logr.Logger) *Controller {
+	return &Controller{
+		log:           log,
+		fallbackLevel: defaultLevel,
+		defaultLevel:  defaultLevel,
+		minLevel:      defaultLevel,
+	}
+}
+
+// Enabled implements zapcore.LevelEnabler with the most verbose configured level, the
+// per-module filter from WrapCore makes the final decision per log entry.
+func (c *Controller) Enabled(level zapcore.Level) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return level >= c.minLevel
+}
+
+// WrapCore wraps the logger core with the per-module level filter, to be passed to
+// zap.WrapCore when building the logger.
+func (c *Controller) WrapCore(core zapcore.Core) zapcore.Core {
+	return &moduleFilterCore{core: core, controller: c}
+}
+
+// WatchConfigFile loads the config file and reloads it whenever it changes. An empty
+// path disables hot reconfiguration, a missing file restores the command line default.
+func (c *Controller) WatchConfigFile(path string) {
+	if len(path) == 0 {
+		return
+	}
+	c.reload(path)
+	go watchFile(path, c.log, func() {
+		c.reload(path)
+	})
+}
+
+// levelFor resolves the level of a dotted logger name by its longest configured prefix.
+func (c *Controller) levelFor(loggerName string) zapcore.Level {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if len(c.moduleLevels) == 0 || len(loggerName) == 0 {
+		return c.defaultLevel
+	}
+	name := loggerName
+	for {
+		if level, found := c.moduleLevels[name]; found {
+			return level
+		}
+		dot := strings.LastIndex(name, ".")
+		if dot < 0 {
+			return c.defaultLevel
+		}
+		name = name[:dot]
+	}
+}
+
+func (c *Controller) reload(path string) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		// a deleted ConfigMap rolls the component back to its command line default
+		content = nil
+	}
+
+	defaultLevel := c.fallbackLevel
+	moduleLevels := map[string]zapcore.Level{}
+	for module, level := range parseConfig(content, c.log) {
+		if module == defaultModuleKey {
+			defaultLevel = level
+			continue
+		}
+		moduleLevels[module] = level
+	}
+
+	minLevel := defaultLevel
+	for _, level := range moduleLevels {
+		if level < minLevel {
+			minLevel = level
+		}
+	}
+
+	c.mu.Lock()
+	changed := c.lastApplied != string(content)
+	c.defaultLevel = defaultLevel
+	c.moduleLevels = moduleLevels
+	c.minLevel = minLevel
+	c.lastApplied = string(content)
+	c.mu.Unlock()
+
+	if changed {
+		c.log.Info("applied dynamic log levels", "default", defaultLevel.String(), "modules", moduleLevels)
+	}
+}
+
+// moduleFilterCore drops entries below the level configured for their module.
+type moduleFilterCore struct {
+	core       zapcore.Core
+	controller *Controller
+}
+
+func (f *moduleFilterCore) Enabled(level zapcore.Level) bool {
+	return f.controller.Enabled(level)
+}
+
+func (f *moduleFilterCore) With(fields []zapcore.Field) zapcore.Core {
+	return &moduleFilterCore{core: f.core.With(fields), controller: f.controller}
+}
+
+func (f *moduleFilterCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if entry.Level < f.controller.levelFor(entry.LoggerName) {
+		return checked
+	}
+	return f.core.Check(entry, checked)
+}
+
+func (f *moduleFilterCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return f.core.Write(entry, fields)
+}
+
+func (f *moduleFilterCore) Sync() error {
+	return f.core.Sync()
+}
+
+// parseConfig reads "<module>=<level>" lines, skipping blank lines, comments and
+// malformed entries so a typo never takes the logging down.
+func parseConfig(content []byte, log logr.Logger) map[string]zapcore.Level {
+	levels := map[string]zapcore.Level{}
+	for module, value := range parseEntries(content, log) {
+		level, err := parseLevel(value)
+		if err != nil {
+			log.Info("skip log level entry with unknown level", "module", module, "level", value)
+			continue
+		}
+		levels[module] = level
+	}
+	return levels
+}
+
+// parseEntries reads the raw "<key>=<value>" lines of a config file.
+func parseEntries(content []byte, log logr.Logger) map[string]string {
+	entries := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			log.Info("skip malformed log level entry", "line", line)
+			continue
+		}
+		entries[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return entries
+}
+
+// parseLevel accepts zap level names (e.g. "debug") and signed numbers, where -n
+// corresponds to logr verbosity V(n).
+func parseLevel(value string) (zapcore.Level, error) {
+	if number, err := strconv.Atoi(value); err == nil {
+		return zapcore.Level(number), nil
+	}
+	var level zapcore.Level
+	err := level.UnmarshalText([]byte(value))
+	return level, err
+}
+
+// watchFile invokes apply whenever the file changes. ConfigMap volumes update via
+// symlink swaps, so the watch covers the whole directory, with periodic reloads as a
+// fallback when the watch can not be established.
+func watchFile(path string, log logr.Logger, apply func()) {
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		if err = watcher.Add(filepath.Dir(path)); err != nil {
+			watcher.Close()
+		}
+	}
+	if err != nil {
+		log.Error(err, "fall back to polling the log level config", "path", path)
+		for range time.Tick(reloadInterval) {
+			apply()
+		}
+		return
+	}
+	defer watcher.Close()
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			apply()
+		case _, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+		case <-ticker.C:
+			apply()
+		}
+	}
+}
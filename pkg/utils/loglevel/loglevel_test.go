@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loglevel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap/zapcore"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestReloadAndLevelFor(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log-level.conf")
+	content := `
+# raise the verbosity of the dataset controller module only
+default=info
+datasetctl=-2
+malformed line
+datasetctl.Dataset=nosuchlevel
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("fail to write the config file: %v", err)
+	}
+
+	controller := NewController(zapcore.InfoLevel, fake.NullLogger())
+	controller.reload(path)
+
+	if level := controller.levelFor("datasetctl.Dataset"); level != zapcore.Level(-2) {
+		t.Errorf("expect the module level to apply by prefix, got %v", level)
+	}
+	if level := controller.levelFor("webhook"); level != zapcore.InfoLevel {
+		t.Errorf("expect the default level for other modules, got %v", level)
+	}
+	if !controller.Enabled(zapcore.Level(-2)) {
+		t.Errorf("expect the coarse gate to open up to the most verbose module level")
+	}
+	if controller.Enabled(zapcore.Level(-3)) {
+		t.Errorf("expect levels below every configured level to stay disabled")
+	}
+
+	// a deleted config rolls back to the command line default
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("fail to remove the config file: %v", err)
+	}
+	controller.reload(path)
+	if level := controller.levelFor("datasetctl.Dataset"); level != zapcore.InfoLevel {
+		t.Errorf("expect the fallback level after the config is gone, got %v", level)
+	}
+	if controller.Enabled(zapcore.Level(-1)) {
+		t.Errorf("expect the coarse gate to fall back with the config, got debug enabled")
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    zapcore.Level
+		wantErr bool
+	}{
+		{value: "debug", want: zapcore.DebugLevel},
+		{value: "info", want: zapcore.InfoLevel},
+		{value: "-3", want: zapcore.Level(-3)},
+		{value: "verbose", wantErr: true},
+	}
+	for _, test := range tests {
+		level, err := parseLevel(test.value)
+		if (err != nil) != test.wantErr {
+			t.Errorf("parseLevel(%q) error = %v, wantErr %v", test.value, err, test.wantErr)
+			continue
+		}
+		if err == nil && level != test.want {
+			t.Errorf("parseLevel(%q) = %v, want %v", test.value, level, test.want)
+		}
+	}
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package utils
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -40,18 +41,24 @@ func GetMountRoot() (string, error) {
 	return mountRoot, nil
 }
 
-func CheckMountReadyAndSubPathExist(fluidPath string, mountType string, subPath string) error {
+// CheckMountReadyAndSubPathExist checks the FUSE mount point, waiting for it to become
+// ready. The wait is bounded by the context so that a cancelled RPC kills the check
+// instead of leaking the script process.
+func CheckMountReadyAndSubPathExist(ctx context.Context, fluidPath string, mountType string, subPath string) error {
 	glog.Infof("Try to check if the mount target %s is ready", fluidPath)
 	if fluidPath == "" {
 		return errors.New("target is not specified for checking the mount")
 	}
 	args := []string{fluidPath, mountType, subPath}
-	command := exec.Command("/usr/local/bin/check_mount.sh", args...)
+	command := exec.CommandContext(ctx, "/usr/local/bin/check_mount.sh", args...)
 	glog.Infoln(command)
 	stdoutStderr, err := command.CombinedOutput()
 	glog.Infoln(string(stdoutStderr))
 
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("the mount readiness check was cancelled: %v", ctxErr)
+		}
 		var checkMountErr *exec.ExitError
 		if errors.As(err, &checkMountErr) {
 			switch checkMountErr.ExitCode() {
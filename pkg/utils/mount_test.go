@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"context"
 	"errors"
 	"os"
 	"os/exec"
@@ -68,7 +69,7 @@ func TestCheckMountReady(t *testing.T) {
 			})
 			defer patch1.Reset()
 
-			err := CheckMountReadyAndSubPathExist("/test", "test", "")
+			err := CheckMountReadyAndSubPathExist(context.TODO(), "/test", "test", "")
 			So(err, ShouldBeNil)
 		})
 		Convey("CheckMountReady false", func() {
@@ -78,11 +79,11 @@ func TestCheckMountReady(t *testing.T) {
 			})
 			defer patch1.Reset()
 
-			err := CheckMountReadyAndSubPathExist("/test", "test", "")
+			err := CheckMountReadyAndSubPathExist(context.TODO(), "/test", "test", "")
 			So(err, ShouldNotBeNil)
 		})
 		Convey("fluidPath nil", func() {
-			err := CheckMountReadyAndSubPathExist("", "test", "")
+			err := CheckMountReadyAndSubPathExist(context.TODO(), "", "test", "")
 			So(err, ShouldNotBeNil)
 		})
 	})
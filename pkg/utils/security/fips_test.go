@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package security
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestVerifyTLSConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		config    *tls.Config
+		expectErr bool
+	}{
+		{
+			name:      "compliant config",
+			config:    &tls.Config{MinVersion: tls.VersionTLS12, CipherSuites: ApprovedCipherSuites()},
+			expectErr: false,
+		},
+		{
+			name:      "tls 1.3 only",
+			config:    &tls.Config{MinVersion: tls.VersionTLS13},
+			expectErr: false,
+		},
+		{
+			name:      "protocol version too low",
+			config:    &tls.Config{MinVersion: tls.VersionTLS10},
+			expectErr: true,
+		},
+		{
+			name: "non-approved cipher suite",
+			config: &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := VerifyTLSConfig(testCase.config)
+		if (err != nil) != testCase.expectErr {
+			t.Errorf("testcase %s failed: expect error %v, got %v", testCase.name, testCase.expectErr, err)
+		}
+	}
+}
+
+func TestVerifyStartup(t *testing.T) {
+	// without strict FIPS mode the verification never fails, whatever the build
+	t.Setenv(FIPSModeEnv, "false")
+	if err := VerifyStartup(fake.NullLogger()); err != nil {
+		t.Errorf("expect no error without strict FIPS mode, got %v", err)
+	}
+
+	// this test binary is built without boringcrypto, so strict mode must refuse to start
+	t.Setenv(FIPSModeEnv, "true")
+	if err := VerifyStartup(fake.NullLogger()); (err != nil) == fipsCryptoModuleEnabled {
+		t.Errorf("expect the startup verification to match the crypto module status, got %v", err)
+	}
+}
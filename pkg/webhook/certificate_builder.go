@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/fluid-cloudnative/fluid/pkg/utils/webhook/generator"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/webhook/writer"
@@ -36,6 +37,10 @@ import (
 type CertificateBuilder struct {
 	log logr.Logger
 	client.Client
+
+	// the latest CA bundle, refreshed on every BuildOrSyncCABundle call
+	mutex  sync.RWMutex
+	caCert []byte
 }
 
 func NewCertificateBuilder(c client.Client, log logr.Logger) *CertificateBuilder {
@@ -58,9 +63,20 @@ func (c *CertificateBuilder) BuildOrSyncCABundle(svcName, cerPath string) ([]byt
 		return []byte{}, err
 	}
 
+	c.mutex.Lock()
+	c.caCert = certs.CACert
+	c.mutex.Unlock()
+
 	return certs.CACert, nil
 }
 
+// CACert returns the CA bundle of the latest built certificate.
+func (c *CertificateBuilder) CACert() []byte {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.caCert
+}
+
 // genCA generate the caBundle and store it in secret and local path
 func (c *CertificateBuilder) genCA(ns, svc, certPath string) (*generator.Artifacts, error) {
 
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// CertRotationCheckPeriodEnv customizes how often the rotator re-validates the
+// webhook certificate, given as a duration string like "12h"
+const CertRotationCheckPeriodEnv = "WEBHOOK_CERT_ROTATION_CHECK_PERIOD"
+
+const defaultCertRotationCheckPeriod = 12 * time.Hour
+
+var _ manager.Runnable = &CertificateRotator{}
+
+// CertificateRotator periodically re-validates the self-managed webhook certificate and
+// regenerates it before it expires, so that a long-running webhook never serves with an
+// expired cert and bricks pod creation. The regenerated cert files are rewritten into
+// the cert dir, where the webhook server's cert watcher picks them up without a restart,
+// and the new CA bundle is patched back to the MutatingWebhookConfiguration.
+type CertificateRotator struct {
+	certBuilder *CertificateBuilder
+	webhookName string
+	svcName     string
+	certDir     string
+}
+
+func NewCertificateRotator(certBuilder *CertificateBuilder, webhookName, svcName, certDir string) *CertificateRotator {
+	return &CertificateRotator{
+		certBuilder: certBuilder,
+		webhookName: webhookName,
+		svcName:     svcName,
+		certDir:     certDir,
+	}
+}
+
+func (r *CertificateRotator) Start(ctx context.Context) error {
+	period := defaultCertRotationCheckPeriod
+	if value, found := os.LookupEnv(CertRotationCheckPeriodEnv); found {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			r.certBuilder.log.Error(err, "failed to parse the rotation check period, fallback to default",
+				"env", CertRotationCheckPeriodEnv, "value", value, "default", defaultCertRotationCheckPeriod)
+		} else {
+			period = parsed
+		}
+	}
+
+	r.certBuilder.log.Info("start the webhook certificate rotator", "period", period)
+	wait.UntilWithContext(ctx, r.rotateOnce, period)
+	return nil
+}
+
+// rotateOnce re-ensures the certificate. BuildOrSyncCABundle regenerates the cert when
+// it is invalid or close to expiry, otherwise it only syncs the existing one, so calling
+// it repeatedly is cheap.
+func (r *CertificateRotator) rotateOnce(ctx context.Context) {
+	previousCA := r.certBuilder.CACert()
+	caCert, err := r.certBuilder.BuildOrSyncCABundle(r.svcName, r.certDir)
+	if err != nil {
+		r.certBuilder.log.Error(err, "failed to re-ensure the webhook certificate, retry in next period")
+		return
+	}
+
+	if bytes.Equal(caCert, previousCA) {
+		return
+	}
+
+	r.certBuilder.log.Info("the webhook certificate is rotated, patching the new CA bundle", "webhook", r.webhookName)
+	if err = r.certBuilder.PatchCABundle(r.webhookName, caCert); err != nil {
+		r.certBuilder.log.Error(err, "failed to patch the rotated CA bundle, retry in next period")
+	}
+}
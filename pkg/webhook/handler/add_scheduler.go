@@ -17,10 +17,12 @@ limitations under the License.
 package handler
 
 import (
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/runtime/warning"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook/scheduler/mutating"
 )
 
 func init() {
 	addHandlers(mutating.HandlerMap)
+	addHandlers(warning.HandlerMap)
 	// addHandlers(validating.HandlerMap)
 }
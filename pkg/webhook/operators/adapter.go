@@ -0,0 +1,123 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operators adapts pods templated by third-party application operators (e.g. the
+// Spark and Flink operators) to Fluid's webhook, so their driver and executor pods get
+// sidecar injection and cache locality like hand-written pods do. Each operator gets a
+// code-level adapter that recognizes its pods and normalizes them before the regular
+// mutating plugins run.
+package operators
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// Adapter normalizes the pods created by one application operator.
+type Adapter interface {
+	// Name returns the adapter name used in logs
+	Name() string
+
+	// Match checks if the pod is templated by the adapter's operator
+	Match(pod *corev1.Pod) bool
+
+	// AdaptPod normalizes the pod so Fluid's mutating plugins handle it correctly
+	AdaptPod(pod *corev1.Pod)
+}
+
+// adapters holds the known operator adapters, checked in order.
+var adapters = []Adapter{
+	&sparkAdapter{},
+	&flinkAdapter{},
+}
+
+// fluid opt-in keys promoted from annotations to labels for operator pods, since some
+// operators only propagate annotations from their pod templates to the created pods.
+var promotedInjectionKeys = []string{
+	common.InjectServerless,
+	common.InjectFuseSidecar,
+	common.InjectUnprivilegedFuseSidecar,
+	common.InjectServerfulFuse,
+	common.InjectAppPostStart,
+	common.EnableFluidInjectionFlag,
+}
+
+// AdaptOperatorPod runs the matching operator adapter on the pod, returns whether the pod
+// is recognized as operator-managed and by which adapter.
+func AdaptOperatorPod(pod *corev1.Pod) (adapted bool, name string) {
+	for _, adapter := range adapters {
+		if !adapter.Match(pod) {
+			continue
+		}
+		promoteInjectionAnnotations(pod)
+		adapter.AdaptPod(pod)
+		return true, adapter.Name()
+	}
+	return false, ""
+}
+
+// ExportLocalityHints mirrors the preferred node affinity injected by Fluid's scheduling
+// plugins into an annotation, so operators' own schedulers (e.g. Spark's executor
+// allocator working off a fixed pod template) can feed the hints back into the pods they
+// create themselves.
+func ExportLocalityHints(pod *corev1.Pod) {
+	if pod.Spec.Affinity == nil || pod.Spec.Affinity.NodeAffinity == nil {
+		return
+	}
+
+	hints := []string{}
+	for _, term := range pod.Spec.Affinity.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		for _, expression := range term.Preference.MatchExpressions {
+			if !strings.HasPrefix(expression.Key, common.LabelAnnotationPrefix) {
+				continue
+			}
+			hint := expression.Key
+			if len(expression.Values) > 0 {
+				hint = hint + "=" + strings.Join(expression.Values, "|")
+			}
+			hints = append(hints, hint)
+		}
+	}
+	if len(hints) == 0 {
+		return
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[common.AnnotationLocalityHints] = strings.Join(hints, ",")
+}
+
+// promoteInjectionAnnotations copies Fluid's injection opt-ins from annotations to the
+// labels the mutating plugins look at, without overriding explicitly set labels.
+func promoteInjectionAnnotations(pod *corev1.Pod) {
+	for _, key := range promotedInjectionKeys {
+		value, found := pod.Annotations[key]
+		if !found {
+			continue
+		}
+		if _, labeled := pod.Labels[key]; labeled {
+			continue
+		}
+		if pod.Labels == nil {
+			pod.Labels = map[string]string{}
+		}
+		pod.Labels[key] = value
+	}
+}
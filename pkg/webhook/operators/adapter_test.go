@@ -0,0 +1,131 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operators
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestAdaptOperatorPod(t *testing.T) {
+	sparkExecutor := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				sparkRoleLabel: sparkRoleExecutor,
+			},
+			Annotations: map[string]string{
+				common.InjectServerless: common.True,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{Name: "istio-proxy"},
+				{Name: "spark-kubernetes-executor"},
+			},
+		},
+	}
+	adapted, name := AdaptOperatorPod(sparkExecutor)
+	if !adapted || name != "spark" {
+		t.Errorf("expect the spark adapter to match, got adapted=%v adapter=%s", adapted, name)
+	}
+	// the opt-in annotation must be promoted to the label the plugins look at
+	if sparkExecutor.Labels[common.InjectServerless] != common.True {
+		t.Errorf("expect the serverless opt-in to be promoted to a label, got %v", sparkExecutor.Labels)
+	}
+	if sparkExecutor.Spec.Containers[0].Name != "spark-kubernetes-executor" {
+		t.Errorf("expect the executor container to stay first, got %v", sparkExecutor.Spec.Containers)
+	}
+
+	flinkTaskManager := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				flinkTypeLabel:      flinkNativeKubernetes,
+				flinkComponentLabel: flinkTaskManagerValue,
+				// an explicitly set label must not be overridden by the annotation
+				common.InjectServerless: common.False,
+			},
+			Annotations: map[string]string{
+				common.InjectServerless: common.True,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: flinkMainContainerName}},
+		},
+	}
+	adapted, name = AdaptOperatorPod(flinkTaskManager)
+	if !adapted || name != "flink" {
+		t.Errorf("expect the flink adapter to match, got adapted=%v adapter=%s", adapted, name)
+	}
+	if flinkTaskManager.Labels[common.InjectServerless] != common.False {
+		t.Errorf("expect the explicit label to win, got %v", flinkTaskManager.Labels)
+	}
+
+	plainPod := &corev1.Pod{}
+	if adapted, _ = AdaptOperatorPod(plainPod); adapted {
+		t.Errorf("expect no adapter to match a plain pod")
+	}
+}
+
+func TestExportLocalityHints(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{sparkRoleLabel: sparkRoleExecutor},
+		},
+		Spec: corev1.PodSpec{
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []corev1.PreferredSchedulingTerm{
+						{
+							Weight: 100,
+							Preference: corev1.NodeSelectorTerm{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{
+										Key:      common.LabelAnnotationPrefix + "s-fluid-hbase",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"true"},
+									},
+									{
+										// non-fluid keys must not leak into the hints
+										Key:      "kubernetes.io/arch",
+										Operator: corev1.NodeSelectorOpIn,
+										Values:   []string{"amd64"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ExportLocalityHints(pod)
+	expected := common.LabelAnnotationPrefix + "s-fluid-hbase=true"
+	if pod.Annotations[common.AnnotationLocalityHints] != expected {
+		t.Errorf("expect locality hints %q, got %q", expected, pod.Annotations[common.AnnotationLocalityHints])
+	}
+
+	noAffinity := &corev1.Pod{}
+	ExportLocalityHints(noAffinity)
+	if len(noAffinity.Annotations) != 0 {
+		t.Errorf("expect no hints without affinity, got %v", noAffinity.Annotations)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operators
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The labels Flink's native kubernetes integration (used by the Flink operator for
+// FlinkDeployments) puts on the jobmanager and taskmanager pods.
+const (
+	flinkComponentLabel    = "component"
+	flinkJobManagerValue   = "jobmanager"
+	flinkTaskManagerValue  = "taskmanager"
+	flinkTypeLabel         = "type"
+	flinkNativeKubernetes  = "flink-native-kubernetes"
+	flinkMainContainerName = "flink-main-container"
+)
+
+// flinkAdapter handles pods templated by the Flink operator. Taskmanager pods are created
+// by the jobmanager from a fixed pod template, the same way Spark executors are.
+type flinkAdapter struct{}
+
+func (f *flinkAdapter) Name() string {
+	return "flink"
+}
+
+func (f *flinkAdapter) Match(pod *corev1.Pod) bool {
+	if pod.Labels[flinkTypeLabel] != flinkNativeKubernetes {
+		return false
+	}
+	component := pod.Labels[flinkComponentLabel]
+	return component == flinkJobManagerValue || component == flinkTaskManagerValue
+}
+
+func (f *flinkAdapter) AdaptPod(pod *corev1.Pod) {
+	// Flink addresses its own container by the fixed name flink-main-container and
+	// expects it first, keep it in front so injected sidecars are appended after it
+	for index, container := range pod.Spec.Containers {
+		if container.Name != flinkMainContainerName {
+			continue
+		}
+		if index != 0 {
+			pod.Spec.Containers[0], pod.Spec.Containers[index] = pod.Spec.Containers[index], pod.Spec.Containers[0]
+		}
+		break
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operators
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The labels the Spark operator and Spark's own kubernetes scheduler backend put on the
+// driver and executor pods they create.
+const (
+	sparkRoleLabel           = "spark-role"
+	sparkRoleDriver          = "driver"
+	sparkRoleExecutor        = "executor"
+	sparkLaunchedByLabel     = "sparkoperator.k8s.io/launched-by-spark-operator"
+	sparkDriverContainerName = "spark-kubernetes-driver"
+)
+
+// sparkAdapter handles pods templated by the Spark operator. Executor pods are created by
+// the driver from a fixed pod template, so opt-ins survive only as annotations there and
+// locality hints have to be exported for Spark's own executor allocator.
+type sparkAdapter struct{}
+
+func (s *sparkAdapter) Name() string {
+	return "spark"
+}
+
+func (s *sparkAdapter) Match(pod *corev1.Pod) bool {
+	if pod.Labels[sparkLaunchedByLabel] == "true" {
+		return true
+	}
+	role := pod.Labels[sparkRoleLabel]
+	return role == sparkRoleDriver || role == sparkRoleExecutor
+}
+
+func (s *sparkAdapter) AdaptPod(pod *corev1.Pod) {
+	// Spark expects its own container to stay the first one, keep the driver or executor
+	// container in front so injected sidecars are appended after it
+	for index, container := range pod.Spec.Containers {
+		if container.Name != sparkDriverContainerName && container.Name != "spark-kubernetes-executor" {
+			continue
+		}
+		if index != 0 {
+			pod.Spec.Containers[0], pod.Spec.Containers[index] = pod.Spec.Containers[index], pod.Spec.Containers[0]
+		}
+		break
+	}
+}
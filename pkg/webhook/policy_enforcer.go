@@ -0,0 +1,155 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// WebhookNamespaceOptOutLabel opts a whole namespace out of the fluid webhook when
+	// set to "disabled", e.g. for system namespaces
+	WebhookNamespaceOptOutLabel = common.LabelAnnotationPrefix + "webhook"
+
+	webhookDisabledValue = "disabled"
+
+	// WebhookFailurePolicyLabel selects the webhook failure policy of a namespace. A
+	// namespace labeled with value "ignore" is served by an Ignore-policy variant of
+	// each webhook, all other namespaces keep the default Fail policy.
+	WebhookFailurePolicyLabel = common.LabelAnnotationPrefix + "webhook-failure-policy"
+
+	webhookFailurePolicyIgnoreValue = "ignore"
+
+	// the name prefix of the generated Ignore-policy webhook variants
+	ignoreVariantPrefix = "ignore."
+)
+
+// PolicyEnforcer reconciles the per-namespace scope and failure policy of fluid's
+// mutating webhook. Namespaces choose their behavior with labels: the opt-out label
+// removes a namespace from the webhook's scope entirely, and the failure policy label
+// switches a namespace from the default Fail policy to Ignore by routing it to a
+// generated Ignore-policy variant of each webhook. The webhook's own namespace is always
+// excluded so that fluid's webhook, CSI and runtime pods never deadlock on cold start.
+type PolicyEnforcer struct {
+	client client.Client
+	log    logr.Logger
+}
+
+func NewPolicyEnforcer(c client.Client, log logr.Logger) *PolicyEnforcer {
+	return &PolicyEnforcer{client: c, log: log}
+}
+
+// Enforce patches the namespace selectors and the Ignore-policy variants of the named
+// MutatingWebhookConfiguration. It is idempotent and safe to call on every reconcile.
+func (p *PolicyEnforcer) Enforce(webhookName string) error {
+	var m v1.MutatingWebhookConfiguration
+
+	ctx := context.Background()
+	if err := p.client.Get(ctx, client.ObjectKey{Name: webhookName}, &m); err != nil {
+		p.log.Error(err, "fail to get mutatingWebHook", "name", webhookName)
+		return err
+	}
+
+	current := m.DeepCopy()
+
+	// drop previously generated variants, they are regenerated below
+	baseWebhooks := make([]v1.MutatingWebhook, 0, len(m.Webhooks))
+	for _, webhook := range m.Webhooks {
+		if !strings.HasPrefix(webhook.Name, ignoreVariantPrefix) {
+			baseWebhooks = append(baseWebhooks, webhook)
+		}
+	}
+
+	fluidNamespace, err := utils.GetEnvByKey(common.MyPodNamespace)
+	if err != nil {
+		// keep enforcing the label-based scoping even without the namespace env
+		fluidNamespace = ""
+	}
+
+	webhooks := make([]v1.MutatingWebhook, 0, 2*len(baseWebhooks))
+	for i := range baseWebhooks {
+		failWebhook := baseWebhooks[i]
+		failWebhook.NamespaceSelector = p.namespaceSelector(fluidNamespace, false)
+		webhooks = append(webhooks, failWebhook)
+
+		ignoreWebhook := *baseWebhooks[i].DeepCopy()
+		ignoreWebhook.Name = ignoreVariantPrefix + baseWebhooks[i].Name
+		ignorePolicy := v1.Ignore
+		ignoreWebhook.FailurePolicy = &ignorePolicy
+		ignoreWebhook.NamespaceSelector = p.namespaceSelector(fluidNamespace, true)
+		webhooks = append(webhooks, ignoreWebhook)
+	}
+	m.Webhooks = webhooks
+
+	if reflect.DeepEqual(m.Webhooks, current.Webhooks) {
+		return nil
+	}
+
+	p.log.Info("patch the per-namespace webhook policies", "name", webhookName)
+	if err := p.client.Patch(ctx, &m, client.MergeFrom(current)); err != nil {
+		p.log.Error(err, "fail to patch the webhook policies", "name", webhookName)
+		return err
+	}
+
+	return nil
+}
+
+// namespaceSelector builds the selector for either the Fail-policy webhook or its
+// Ignore-policy variant. Both exclude opted-out namespaces and the fluid system
+// namespace, and the failure policy label decides which of the two serves a namespace.
+func (p *PolicyEnforcer) namespaceSelector(fluidNamespace string, ignoreVariant bool) *metav1.LabelSelector {
+	matchExpressions := []metav1.LabelSelectorRequirement{
+		{
+			Key:      WebhookNamespaceOptOutLabel,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{webhookDisabledValue},
+		},
+	}
+
+	if ignoreVariant {
+		matchExpressions = append(matchExpressions, metav1.LabelSelectorRequirement{
+			Key:      WebhookFailurePolicyLabel,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   []string{webhookFailurePolicyIgnoreValue},
+		})
+	} else {
+		matchExpressions = append(matchExpressions, metav1.LabelSelectorRequirement{
+			Key:      WebhookFailurePolicyLabel,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{webhookFailurePolicyIgnoreValue},
+		})
+	}
+
+	if fluidNamespace != "" {
+		matchExpressions = append(matchExpressions, metav1.LabelSelectorRequirement{
+			Key:      "kubernetes.io/metadata.name",
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{fluidNamespace},
+		})
+	}
+
+	return &metav1.LabelSelector{MatchExpressions: matchExpressions}
+}
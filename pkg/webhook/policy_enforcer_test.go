@@ -0,0 +1,94 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestEnforceWebhookPolicies(t *testing.T) {
+	mwcName := "fluid-pod-admission-webhook"
+	failPolicy := admissionregistrationv1.Fail
+	mwc := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: mwcName,
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{
+			{
+				Name:          "sidecar.fuse.fluid.io",
+				FailurePolicy: &failPolicy,
+			},
+		},
+	}
+
+	s := runtime.NewScheme()
+	_ = admissionregistrationv1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s, mwc)
+
+	enforcer := NewPolicyEnforcer(c, fake.NullLogger())
+
+	// enforcing twice must converge to the same result
+	for i := 0; i < 2; i++ {
+		if err := enforcer.Enforce(mwcName); err != nil {
+			t.Fatalf("fail to enforce the webhook policies: %v", err)
+		}
+	}
+
+	var got admissionregistrationv1.MutatingWebhookConfiguration
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: mwcName}, &got); err != nil {
+		t.Fatalf("fail to get the mutating webhook configuration: %v", err)
+	}
+
+	if len(got.Webhooks) != 2 {
+		t.Fatalf("expect 2 webhooks after enforcing, got %d", len(got.Webhooks))
+	}
+
+	base, variant := got.Webhooks[0], got.Webhooks[1]
+	if variant.Name != "ignore.sidecar.fuse.fluid.io" {
+		t.Errorf("expect the ignore variant name to be ignore.sidecar.fuse.fluid.io, got %s", variant.Name)
+	}
+	if variant.FailurePolicy == nil || *variant.FailurePolicy != admissionregistrationv1.Ignore {
+		t.Errorf("expect the variant's failure policy to be Ignore, got %v", variant.FailurePolicy)
+	}
+	if base.FailurePolicy == nil || *base.FailurePolicy != admissionregistrationv1.Fail {
+		t.Errorf("expect the base webhook's failure policy to stay Fail, got %v", base.FailurePolicy)
+	}
+
+	expectRequirement := func(selector *metav1.LabelSelector, key string, op metav1.LabelSelectorOperator) {
+		if selector == nil {
+			t.Fatalf("expect a namespace selector, got nil")
+		}
+		for _, requirement := range selector.MatchExpressions {
+			if requirement.Key == key && requirement.Operator == op {
+				return
+			}
+		}
+		t.Errorf("expect a namespace selector requirement %s %s, got %v", key, op, selector.MatchExpressions)
+	}
+
+	expectRequirement(base.NamespaceSelector, WebhookNamespaceOptOutLabel, metav1.LabelSelectorOpNotIn)
+	expectRequirement(base.NamespaceSelector, WebhookFailurePolicyLabel, metav1.LabelSelectorOpNotIn)
+	expectRequirement(variant.NamespaceSelector, WebhookNamespaceOptOutLabel, metav1.LabelSelectorOpNotIn)
+	expectRequirement(variant.NamespaceSelector, WebhookFailurePolicyLabel, metav1.LabelSelectorOpIn)
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package warning surfaces risky or deprecated runtime configurations as Kubernetes
+// admission warnings at create and update time, instead of letting them fail silently
+// later during reconcile. The handler never rejects a request: warnings show up in
+// kubectl output and the request is always allowed.
+package warning
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// CreateUpdateRuntimeHandler warns about deprecated fields and risky configurations on
+// runtimes and has implemented admission.DecoderInjector
+type CreateUpdateRuntimeHandler struct {
+	Client client.Client
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+
+	log logr.Logger
+}
+
+func (a *CreateUpdateRuntimeHandler) Setup(client client.Client) {
+	a.Client = client
+	a.log = ctrl.Log.WithName("runtime-warning")
+}
+
+// Handle collects warnings for the runtime and always allows the request
+func (a *CreateUpdateRuntimeHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	runtime := &datav1alpha1.AlluxioRuntime{}
+	err := a.decoder.Decode(req, runtime)
+	if err != nil {
+		a.log.Error(err, "unable to decode runtime from req", "name", req.Name, "namespace", req.Namespace)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	warnings := a.collectWarnings(ctx, runtime)
+	if len(warnings) != 0 {
+		a.log.Info("emit admission warnings for runtime", "name", runtime.Name, "namespace", runtime.Namespace, "warnings", warnings)
+	}
+
+	return admission.Allowed("").WithWarnings(warnings...)
+}
+
+// InjectDecoder injects the decoder.
+func (a *CreateUpdateRuntimeHandler) InjectDecoder(d *admission.Decoder) error {
+	a.decoder = d
+	return nil
+}
+
+func (a *CreateUpdateRuntimeHandler) collectWarnings(ctx context.Context, runtime *datav1alpha1.AlluxioRuntime) (warnings []string) {
+	warnings = append(warnings, collectDeprecationWarnings(runtime)...)
+	warnings = append(warnings, a.collectMemoryTierWarnings(ctx, runtime)...)
+	warnings = append(warnings, a.collectHostNetworkPortWarnings(ctx, runtime)...)
+	return
+}
+
+// collectDeprecationWarnings warns about fields that still work but are deprecated, so
+// users hear about them when they apply the runtime instead of from a release note.
+func collectDeprecationWarnings(runtime *datav1alpha1.AlluxioRuntime) (warnings []string) {
+	if runtime.Spec.Fuse.Global {
+		warnings = append(warnings,
+			"spec.fuse.global is deprecated and will be removed in a future release, fuse pods already follow the application pods that actually mount the dataset")
+	}
+	return
+}
+
+// collectMemoryTierWarnings warns when the memory tier quota can not fit on any node in
+// the cluster. The runtime would be accepted but its workers would be evicted or stay
+// pending once the cache fills up, which is hard to trace back to the tieredstore.
+func (a *CreateUpdateRuntimeHandler) collectMemoryTierWarnings(ctx context.Context, runtime *datav1alpha1.AlluxioRuntime) (warnings []string) {
+	memQuota := memoryTierQuota(runtime.Spec.TieredStore)
+	if memQuota.IsZero() {
+		return
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := a.Client.List(ctx, nodeList); err != nil {
+		// the check is best-effort, never fail the admission over it
+		a.log.Error(err, "skip the memory tier check because nodes can not be listed")
+		return
+	}
+	if len(nodeList.Items) == 0 {
+		return
+	}
+
+	maxAllocatable := resource.Quantity{}
+	for _, node := range nodeList.Items {
+		allocatable := node.Status.Allocatable[corev1.ResourceMemory]
+		if allocatable.Cmp(maxAllocatable) > 0 {
+			maxAllocatable = allocatable
+		}
+	}
+
+	if memQuota.Cmp(maxAllocatable) > 0 {
+		warnings = append(warnings,
+			fmt.Sprintf("the memory tier quota %s exceeds the largest node allocatable memory %s, cache workers will be evicted or stay pending once the tier fills up",
+				memQuota.String(), maxAllocatable.String()))
+	}
+	return
+}
+
+// collectHostNetworkPortWarnings warns about explicitly declared ports that collide when
+// components share the host network, either inside this runtime or with another host
+// network runtime in the cluster. Fluid only auto-allocates ports that are not declared,
+// so declared duplicates surface as bind failures at pod start.
+func (a *CreateUpdateRuntimeHandler) collectHostNetworkPortWarnings(ctx context.Context, runtime *datav1alpha1.AlluxioRuntime) (warnings []string) {
+	declaredPorts := hostNetworkPorts(runtime)
+	if len(declaredPorts) == 0 {
+		return
+	}
+
+	for _, port := range sortedPorts(declaredPorts) {
+		holders := declaredPorts[port]
+		if len(holders) > 1 {
+			warnings = append(warnings,
+				fmt.Sprintf("port %d is declared by %s which share the host network, the later pods will fail to bind it",
+					port, strings.Join(holders, " and ")))
+		}
+	}
+
+	runtimeList := &datav1alpha1.AlluxioRuntimeList{}
+	if err := a.Client.List(ctx, runtimeList); err != nil {
+		a.log.Error(err, "skip the cross-runtime port check because runtimes can not be listed")
+		return
+	}
+	for i := range runtimeList.Items {
+		other := &runtimeList.Items[i]
+		if other.Namespace == runtime.Namespace && other.Name == runtime.Name {
+			continue
+		}
+		otherPorts := hostNetworkPorts(other)
+		for _, port := range sortedPorts(declaredPorts) {
+			if _, conflicting := otherPorts[port]; conflicting {
+				warnings = append(warnings,
+					fmt.Sprintf("port %d is also declared by the host network runtime %s/%s, the pods will conflict if they land on the same node",
+						port, other.Namespace, other.Name))
+			}
+		}
+	}
+	return
+}
+
+// memoryTierQuota sums the quotas of all MEM levels of the tiered store, including the
+// per-path quota lists.
+func memoryTierQuota(tieredStore datav1alpha1.TieredStore) resource.Quantity {
+	total := resource.Quantity{}
+	for _, level := range tieredStore.Levels {
+		if level.MediumType != common.Memory {
+			continue
+		}
+		if level.Quota != nil {
+			total.Add(*level.Quota)
+			continue
+		}
+		for _, quota := range strings.Split(level.QuotaList, ",") {
+			parsed, err := resource.ParseQuantity(strings.TrimSpace(quota))
+			if err != nil {
+				// leave malformed quotas to the runtime controller's own validation
+				continue
+			}
+			total.Add(parsed)
+		}
+	}
+	return total
+}
+
+// hostNetworkPorts maps each explicitly declared port to the components declaring it,
+// only counting components that run with the host network.
+func hostNetworkPorts(runtime *datav1alpha1.AlluxioRuntime) map[int][]string {
+	components := []struct {
+		name        string
+		networkMode datav1alpha1.NetworkMode
+		ports       map[string]int
+	}{
+		{"master", runtime.Spec.Master.NetworkMode, runtime.Spec.Master.Ports},
+		{"jobMaster", runtime.Spec.JobMaster.NetworkMode, runtime.Spec.JobMaster.Ports},
+		{"worker", runtime.Spec.Worker.NetworkMode, runtime.Spec.Worker.Ports},
+		{"jobWorker", runtime.Spec.JobWorker.NetworkMode, runtime.Spec.JobWorker.Ports},
+		{"apiGateway", runtime.Spec.APIGateway.NetworkMode, runtime.Spec.APIGateway.Ports},
+	}
+
+	declaredPorts := map[int][]string{}
+	for _, component := range components {
+		if !datav1alpha1.IsHostNetwork(component.networkMode) {
+			continue
+		}
+		for portName, port := range component.ports {
+			declaredPorts[port] = append(declaredPorts[port], fmt.Sprintf("%s(%s)", component.name, portName))
+		}
+	}
+	for port := range declaredPorts {
+		sort.Strings(declaredPorts[port])
+	}
+	return declaredPorts
+}
+
+func sortedPorts(ports map[int][]string) []int {
+	keys := make([]int, 0, len(ports))
+	for port := range ports {
+		keys = append(keys, port)
+	}
+	sort.Ints(keys)
+	return keys
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warning
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func newTestHandler(t *testing.T, objects ...runtime.Object) *CreateUpdateRuntimeHandler {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := datav1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the fluid scheme: %v", err)
+	}
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatalf("fail to add the core scheme: %v", err)
+	}
+	handler := &CreateUpdateRuntimeHandler{}
+	handler.Setup(fake.NewFakeClientWithScheme(s, objects...))
+	return handler
+}
+
+func hasWarningContaining(warnings []string, keyword string) bool {
+	for _, warning := range warnings {
+		if strings.Contains(warning, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCollectWarningsForRiskyRuntime(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("32Gi"),
+			},
+		},
+	}
+	otherRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "spark", Namespace: "big-data"},
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			Worker: datav1alpha1.AlluxioCompTemplateSpec{
+				Ports: map[string]int{"rpc": 29999},
+			},
+		},
+	}
+
+	quota := resource.MustParse("64Gi")
+	runtime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			Master: datav1alpha1.AlluxioCompTemplateSpec{
+				Ports: map[string]int{"rpc": 19998, "web": 29999},
+			},
+			Worker: datav1alpha1.AlluxioCompTemplateSpec{
+				Ports: map[string]int{"rpc": 19998},
+			},
+			Fuse: datav1alpha1.AlluxioFuseSpec{
+				Global: true,
+			},
+			TieredStore: datav1alpha1.TieredStore{
+				Levels: []datav1alpha1.Level{
+					{
+						MediumType: common.Memory,
+						Quota:      &quota,
+					},
+				},
+			},
+		},
+	}
+
+	handler := newTestHandler(t, node, otherRuntime, runtime)
+	warnings := handler.collectWarnings(context.TODO(), runtime)
+
+	if !hasWarningContaining(warnings, "spec.fuse.global is deprecated") {
+		t.Errorf("expect a deprecation warning for spec.fuse.global, got %v", warnings)
+	}
+	if !hasWarningContaining(warnings, "memory tier quota 64Gi exceeds the largest node allocatable memory 32Gi") {
+		t.Errorf("expect a memory tier warning, got %v", warnings)
+	}
+	if !hasWarningContaining(warnings, "port 19998 is declared by master(rpc) and worker(rpc)") {
+		t.Errorf("expect a warning for the port declared twice in the spec, got %v", warnings)
+	}
+	if !hasWarningContaining(warnings, "port 29999 is also declared by the host network runtime big-data/spark") {
+		t.Errorf("expect a warning for the port conflicting with another runtime, got %v", warnings)
+	}
+}
+
+func TestCollectWarningsForSafeRuntime(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("32Gi"),
+			},
+		},
+	}
+
+	quota := resource.MustParse("2Gi")
+	runtime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			// explicit ports do not conflict when the components opt out of the host network
+			Master: datav1alpha1.AlluxioCompTemplateSpec{
+				NetworkMode: datav1alpha1.ContainerNetworkMode,
+				Ports:       map[string]int{"rpc": 19998},
+			},
+			Worker: datav1alpha1.AlluxioCompTemplateSpec{
+				NetworkMode: datav1alpha1.ContainerNetworkMode,
+				Ports:       map[string]int{"rpc": 19998},
+			},
+			TieredStore: datav1alpha1.TieredStore{
+				Levels: []datav1alpha1.Level{
+					{
+						MediumType: common.Memory,
+						Quota:      &quota,
+					},
+				},
+			},
+		},
+	}
+
+	handler := newTestHandler(t, node, runtime)
+	if warnings := handler.collectWarnings(context.TODO(), runtime); len(warnings) != 0 {
+		t.Errorf("expect no warnings for a safe runtime, got %v", warnings)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook/cache"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/operators"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -69,6 +70,14 @@ func (a *CreateUpdatePodForSchedulingHandler) Handle(ctx context.Context, req ad
 	if len(namespace) == 0 {
 		namespace = req.Namespace
 	}
+
+	// normalize pods templated by application operators (e.g. Spark or Flink) before
+	// checking the opt-in labels, since operators may only propagate annotations
+	operatorManaged, adapterName := operators.AdaptOperatorPod(pod)
+	if operatorManaged {
+		setupLog.V(1).Info("normalized the operator-managed pod", "adapter", adapterName, "Pod", pod.Name, "Namespace", namespace)
+	}
+
 	// check whether should inject
 	if common.CheckExpectValue(pod.Labels, common.EnableFluidInjectionFlag, common.False) {
 		setupLog.Info("skip mutating the pod because injection is disabled", "Pod", pod.Name, "Namespace", pod.Namespace)
@@ -89,6 +98,11 @@ func (a *CreateUpdatePodForSchedulingHandler) Handle(ctx context.Context, req ad
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
+	// mirror the injected locality preferences for the operator's own scheduler
+	if operatorManaged {
+		operators.ExportLocalityHints(pod)
+	}
+
 	marshaledPod, err := json.Marshal(pod)
 	if err != nil {
 		setupLog.Error(err, "unable to marshal pod")